@@ -0,0 +1,7 @@
+data "neo4j_aliases" "example" {
+  database = "movies"
+}
+
+output "movies_aliases" {
+  value = [for a in data.neo4j_aliases.example.aliases : a.name]
+}
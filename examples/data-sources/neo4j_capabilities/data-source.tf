@@ -0,0 +1,7 @@
+data "neo4j_capabilities" "this" {}
+
+resource "neo4j_graphml_export" "backup" {
+  count = data.neo4j_capabilities.this.apoc_installed ? 1 : 0
+
+  file = "backup.graphml"
+}
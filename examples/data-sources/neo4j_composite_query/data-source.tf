@@ -0,0 +1,8 @@
+data "neo4j_composite_query" "persons" {
+  composite = "customers"
+  query     = "MATCH (n:Person) RETURN count(n) AS persons"
+}
+
+output "persons_per_shard" {
+  value = { for row in data.neo4j_composite_query.persons.rows : row.graph => row.persons }
+}
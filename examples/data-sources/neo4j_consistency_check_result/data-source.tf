@@ -0,0 +1,10 @@
+data "neo4j_consistency_check_result" "orphaned_orders" {
+  name = neo4j_consistency_check.orphaned_orders.name
+}
+
+check "no_orphaned_orders" {
+  assert {
+    condition     = coalesce(data.neo4j_consistency_check_result.orphaned_orders.violations, 0) == 0
+    error_message = "Orders without customers found."
+  }
+}
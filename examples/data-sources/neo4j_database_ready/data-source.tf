@@ -0,0 +1,11 @@
+data "neo4j_database_ready" "movies" {
+  name    = "movies"
+  timeout = "10m"
+}
+
+resource "neo4j_json_import" "movies" {
+  depends_on = [data.neo4j_database_ready.movies]
+
+  url       = "https://example.com/movies.json"
+  statement = "MERGE (:Movie{title: value.title})"
+}
@@ -0,0 +1,8 @@
+data "neo4j_generated_config" "countries" {
+  labels = ["Country", "City"]
+}
+
+resource "local_file" "countries" {
+  filename = "${path.module}/countries.tf.generated"
+  content  = data.neo4j_generated_config.countries.hcl
+}
@@ -0,0 +1,8 @@
+data "neo4j_graph_checksum" "reference_data" {
+  labels             = ["Country", "City"]
+  relationship_types = ["LOCATED_IN"]
+}
+
+output "reference_data_checksum" {
+  value = data.neo4j_graph_checksum.reference_data.checksum
+}
@@ -0,0 +1,13 @@
+data "neo4j_graph_snapshot" "reference_data" {
+  labels             = ["Country", "City"]
+  relationship_types = ["LOCATED_IN"]
+  previous           = fileexists("${path.module}/snapshot.json") ? file("${path.module}/snapshot.json") : null
+}
+
+output "reference_data_changes" {
+  value = {
+    nodes_added   = data.neo4j_graph_snapshot.reference_data.nodes_added
+    nodes_removed = data.neo4j_graph_snapshot.reference_data.nodes_removed
+    nodes_changed = data.neo4j_graph_snapshot.reference_data.nodes_changed
+  }
+}
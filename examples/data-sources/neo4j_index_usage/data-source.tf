@@ -0,0 +1,8 @@
+data "neo4j_index_usage" "this" {}
+
+check "unused_indexes" {
+  assert {
+    condition     = length(data.neo4j_index_usage.this.unused) == 0
+    error_message = "The indexes are not used: ${join(", ", data.neo4j_index_usage.this.unused)}."
+  }
+}
@@ -0,0 +1,12 @@
+data "neo4j_node_count" "hub" {
+  labels            = ["Country"]
+  relationship_type = "BORDERS"
+  node_id           = neo4j_node.germany.id
+}
+
+check "topology" {
+  assert {
+    condition     = data.neo4j_node_count.hub.degree > 0
+    error_message = "Germany shall border other countries."
+  }
+}
@@ -0,0 +1,11 @@
+variable "city_ids" {
+  type = list(string)
+}
+
+data "neo4j_nodes_by_ids" "cities" {
+  ids = var.city_ids
+}
+
+output "city_names" {
+  value = { for id, node in data.neo4j_nodes_by_ids.cities.nodes : id => node.properties["name"] }
+}
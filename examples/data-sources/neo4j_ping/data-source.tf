@@ -0,0 +1,8 @@
+data "neo4j_ping" "eu" {}
+
+check "connected_to_eu_cluster" {
+  assert {
+    condition     = alltrue([for address in data.neo4j_ping.eu.writers : endswith(address, ".eu.example.com:7687")])
+    error_message = "The provider is not connected to the EU cluster."
+  }
+}
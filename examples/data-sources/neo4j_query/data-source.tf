@@ -0,0 +1,9 @@
+data "neo4j_query" "countries" {
+  query   = "MATCH (c:Country{continent: $continent}) RETURN c.code AS code, c.population AS population"
+  params  = { continent = "Europe" }
+  columns = { code = "string", population = "number" }
+}
+
+output "population" {
+  value = sum(data.neo4j_query.countries.rows[*].population)
+}
@@ -0,0 +1,8 @@
+data "neo4j_query_export" "countries" {
+  query  = "MATCH (c:Country) RETURN c.code AS code, c.name AS name ORDER BY code"
+  format = "csv"
+}
+
+output "countries_csv" {
+  value = data.neo4j_query_export.countries.data
+}
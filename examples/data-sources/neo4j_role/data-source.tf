@@ -0,0 +1,10 @@
+data "neo4j_role" "reader" {
+  name = "reader"
+}
+
+check "reader_is_read_only" {
+  assert {
+    condition     = alltrue([for p in data.neo4j_role.reader.privileges : !startswith(p, "GRANT WRITE")])
+    error_message = "The reader role must not be granted to write."
+  }
+}
@@ -0,0 +1,8 @@
+data "neo4j_servers" "cluster" {}
+
+check "eu_servers" {
+  assert {
+    condition     = length([for s in data.neo4j_servers.cluster.servers : s if contains(s.tags, "eu")]) >= 3
+    error_message = "At least three servers shall be tagged to host the databases in the EU."
+  }
+}
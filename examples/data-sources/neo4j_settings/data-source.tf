@@ -0,0 +1,10 @@
+data "neo4j_settings" "example" {
+  name_pattern = "dbms\\.security\\..*"
+}
+
+check "auth_enabled" {
+  assert {
+    condition     = data.neo4j_settings.example.settings["dbms.security.auth_enabled"] == "true"
+    error_message = "Authentication must be enabled."
+  }
+}
@@ -0,0 +1,13 @@
+data "neo4j_traversal" "downstream" {
+  start_node_id      = neo4j_node.gateway.id
+  relationship_types = ["CALLS"]
+  max_depth          = 5
+}
+
+resource "neo4j_relationship" "monitored" {
+  for_each = toset(data.neo4j_traversal.downstream.node_ids)
+
+  type          = "MONITORS"
+  start_node_id = neo4j_node.monitoring.id
+  end_node_id   = each.value
+}
@@ -0,0 +1,8 @@
+data "neo4j_triggers" "example" {}
+
+check "no_paused_triggers" {
+  assert {
+    condition     = alltrue([for t in data.neo4j_triggers.example.triggers : !t.paused])
+    error_message = "All triggers must be active."
+  }
+}
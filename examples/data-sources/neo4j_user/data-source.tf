@@ -0,0 +1,7 @@
+data "neo4j_user" "analyst" {
+  name = "analyst"
+}
+
+output "analyst_roles" {
+  value = data.neo4j_user.analyst.roles
+}
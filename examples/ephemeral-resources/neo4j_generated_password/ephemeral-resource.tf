@@ -0,0 +1,9 @@
+ephemeral "neo4j_generated_password" "app" {
+  length           = 32
+  override_special = "-_"
+}
+
+ephemeral "neo4j_temporary_credentials" "app" {
+  name_prefix = "app_"
+  password    = ephemeral.neo4j_generated_password.app.result
+}
@@ -0,0 +1,10 @@
+ephemeral "neo4j_temporary_credentials" "app" {
+  name_prefix = "app_"
+  roles       = ["reader"]
+}
+
+provider "neo4j" {
+  alias       = "app"
+  db_user     = ephemeral.neo4j_temporary_credentials.app.username
+  db_password = ephemeral.neo4j_temporary_credentials.app.password
+}
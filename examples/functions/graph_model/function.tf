@@ -0,0 +1,19 @@
+locals {
+  graph = provider::neo4j::graph_model({
+    "Person:alice" = { KNOWS = ["bob"], WORKS_AT = ["Company:acme"] }
+    "Person:bob"   = {}
+  })
+}
+
+resource "neo4j_node" "this" {
+  for_each   = local.graph.nodes
+  labels     = each.value.labels
+  properties = { name = each.key }
+}
+
+resource "neo4j_relationship" "this" {
+  for_each      = local.graph.relationships
+  type          = each.value.type
+  start_node_id = neo4j_node.this[each.value.start].id
+  end_node_id   = neo4j_node.this[each.value.end].id
+}
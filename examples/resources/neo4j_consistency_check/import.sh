@@ -0,0 +1,2 @@
+# The consistency check is imported by its name.
+terraform import neo4j_consistency_check.orphaned_orders orphaned_orders
@@ -0,0 +1,5 @@
+resource "neo4j_consistency_check" "orphaned_orders" {
+  name  = "orphaned_orders"
+  query = "MATCH (o:Order) WHERE NOT (o)<-[:PLACED]-(:Customer) RETURN o"
+  rate  = 900
+}
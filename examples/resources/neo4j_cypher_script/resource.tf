@@ -0,0 +1,9 @@
+resource "neo4j_cypher_script" "migration" {
+  file = "${path.module}/migrations/001_reference_data.cypher"
+}
+
+resource "neo4j_cypher_script" "countries" {
+  file            = "${path.module}/migrations/002_countries.cypher"
+  parameter_files = ["${path.module}/migrations/countries.yaml"]
+  read_query      = "MATCH (c:Country) RETURN count(c) = size($countries)"
+}
@@ -0,0 +1,2 @@
+# The database is imported by its name.
+terraform import neo4j_database.orders orders
@@ -0,0 +1,12 @@
+variable "seed_credentials" {
+  type      = string
+  sensitive = true
+}
+
+# Create the database from the backup stored in S3.
+resource "neo4j_database" "orders" {
+  name             = "orders"
+  seed_uri         = "s3://backups/orders-2024-01-01.backup"
+  seed_credentials = var.seed_credentials
+  seed_config      = "region=eu-west-1"
+}
@@ -0,0 +1,2 @@
+# The database alias is imported by its name.
+terraform import neo4j_database_alias.orders orders
@@ -0,0 +1,7 @@
+resource "neo4j_database_alias" "orders" {
+  name     = "orders"
+  database = "orders-2024-01"
+  properties = {
+    team = "commerce"
+  }
+}
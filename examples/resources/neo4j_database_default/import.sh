@@ -0,0 +1,2 @@
+# The default database is imported by its name, the previous default database is not known, hence it's kept on destroy.
+terraform import neo4j_database_default.this movies-v2
@@ -0,0 +1,3 @@
+resource "neo4j_database_default" "this" {
+  database = "movies-v2"
+}
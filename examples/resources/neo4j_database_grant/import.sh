@@ -0,0 +1,2 @@
+# The database privileges are imported by the database and the role names.
+terraform import neo4j_database_grant.analysts movies/analyst
@@ -0,0 +1,11 @@
+resource "neo4j_database_grant" "analysts" {
+  database   = "movies"
+  role       = "analyst"
+  privileges = ["ACCESS"]
+}
+
+resource "neo4j_database_grant" "operators" {
+  database   = "*"
+  role       = "operator"
+  privileges = ["ACCESS", "START", "STOP"]
+}
@@ -0,0 +1,2 @@
+# The remote database alias is imported by its name, its password is set on the next apply.
+terraform import neo4j_database_remote_alias.orders remote-orders
@@ -0,0 +1,25 @@
+variable "remote_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "neo4j_database_remote_alias" "orders" {
+  name     = "remote-orders"
+  database = "orders"
+  url      = "neo4j+s://remote.example.com:7687"
+  user     = "alias_user"
+  password = var.remote_password
+
+  # Increment to rotate the password.
+  password_version = 1
+
+  properties = {
+    team = "commerce"
+  }
+
+  driver {
+    ssl_enforced             = true
+    connection_timeout       = "5s"
+    connection_pool_max_size = 10
+  }
+}
@@ -0,0 +1,2 @@
+# The database topology is imported by the database name.
+terraform import neo4j_database_topology.orders orders
@@ -0,0 +1,5 @@
+resource "neo4j_database_topology" "orders" {
+  database    = "orders"
+  primaries   = 3
+  secondaries = 2
+}
@@ -0,0 +1,2 @@
+# The virtual resource is imported by its name.
+terraform import neo4j_dv_catalog.people people
@@ -0,0 +1,8 @@
+resource "neo4j_dv_catalog" "people" {
+  name        = "people"
+  type        = "CSV"
+  url         = "https://example.com/people.csv"
+  query       = "map.name = $name"
+  description = "People by name."
+  labels      = ["Person"]
+}
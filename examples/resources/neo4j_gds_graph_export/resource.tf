@@ -0,0 +1,4 @@
+resource "neo4j_gds_graph_export" "example" {
+  graph_name    = "movies-projection"
+  database_name = "moviesanalytics"
+}
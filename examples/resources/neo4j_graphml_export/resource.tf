@@ -0,0 +1,19 @@
+# Exports the whole graph to a GraphML file on every change of the release tag,
+# and before the resource is destroyed.
+resource "neo4j_graphml_export" "backup" {
+  file              = "backup.graphml"
+  export_on_destroy = true
+  triggers = {
+    release = var.release
+  }
+}
+
+# Exports the subgraph selected by the query.
+resource "neo4j_graphml_export" "movies" {
+  file  = "movies.graphml"
+  query = "MATCH p = (:Movie)<-[:ACTED_IN]-(:Person) RETURN p"
+}
+
+variable "release" {
+  type = string
+}
@@ -0,0 +1,3 @@
+# The index is imported by its name, optionally prefixed by the database name.
+terraform import neo4j_index.person_name person_name
+terraform import neo4j_index.person_name neo4j/person_name
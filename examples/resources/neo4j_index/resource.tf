@@ -0,0 +1,14 @@
+resource "neo4j_index" "person_name" {
+  name          = "person_name"
+  entity_type   = "NODE"
+  label_or_type = "Person"
+  properties    = ["firstname", "surname"]
+}
+
+resource "neo4j_index" "knows_since" {
+  name          = "knows_since"
+  index_type    = "TEXT"
+  entity_type   = "RELATIONSHIP"
+  label_or_type = "KNOWS"
+  properties    = ["since"]
+}
@@ -0,0 +1,13 @@
+resource "neo4j_json_import" "people" {
+  url       = "https://example.com/people.json"
+  statement = <<EOT
+MERGE (p:Person{name: value.name})
+SET p.age = value.age
+EOT
+}
+
+# The graph drawn with Arrows.app and exported as JSON.
+resource "neo4j_json_import" "domain_model" {
+  url    = "file:///domain-model.json"
+  format = "arrows"
+}
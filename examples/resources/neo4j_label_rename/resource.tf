@@ -0,0 +1,4 @@
+resource "neo4j_label_rename" "client_to_customer" {
+  from = "Client"
+  to   = "Customer"
+}
@@ -0,0 +1,3 @@
+# The lookup index is imported by its name, optionally prefixed by the database name.
+terraform import neo4j_lookup_index.labels node_labels_lookup
+terraform import neo4j_lookup_index.labels neo4j/node_labels_lookup
@@ -0,0 +1,9 @@
+resource "neo4j_lookup_index" "labels" {
+  name        = "node_labels_lookup"
+  entity_type = "NODE"
+}
+
+resource "neo4j_lookup_index" "types" {
+  name        = "relationship_types_lookup"
+  entity_type = "RELATIONSHIP"
+}
@@ -0,0 +1,3 @@
+# The node is imported by its identifier, optionally prefixed by the database name.
+terraform import neo4j_node.example 0b6c3fa7-0c4f-4b2d-9b1e-3c1f0c9b7a2e
+terraform import neo4j_node.example neo4j/0b6c3fa7-0c4f-4b2d-9b1e-3c1f0c9b7a2e
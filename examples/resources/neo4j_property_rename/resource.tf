@@ -0,0 +1,6 @@
+resource "neo4j_property_rename" "person_name" {
+  entity_type   = "NODE"
+  label_or_type = "Person"
+  from          = "name"
+  to            = "full_name"
+}
@@ -0,0 +1,3 @@
+# The relationship is imported by its identifier, optionally prefixed by the database name.
+terraform import neo4j_relationship.with_props 5e0f3b1c-2a7d-4c8e-9f6a-1b2c3d4e5f60
+terraform import neo4j_relationship.with_props neo4j/5e0f3b1c-2a7d-4c8e-9f6a-1b2c3d4e5f60
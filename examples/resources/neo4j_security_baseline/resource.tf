@@ -0,0 +1,6 @@
+resource "neo4j_security_baseline" "this" {
+  privileges = [
+    "GRANT ACCESS ON DATABASE movies TO reader",
+    "GRANT MATCH {*} ON GRAPH movies TO reader",
+  ]
+}
@@ -0,0 +1,2 @@
+# The server is imported by its name.
+terraform import neo4j_server.eu_1 server-1
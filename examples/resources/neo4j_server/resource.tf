@@ -0,0 +1,6 @@
+resource "neo4j_server" "eu_1" {
+  name             = "server-1"
+  tags             = ["eu", "ssd"]
+  denied_databases = ["analytics"]
+  mode_constraint  = "PRIMARY"
+}
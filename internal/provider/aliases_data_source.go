@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AliasesDataSource{}
+
+func NewAliasesDataSource() datasource.DataSource {
+	return &AliasesDataSource{}
+}
+
+// AliasesDataSource defines the `SHOW ALIASES` data source implementation.
+type AliasesDataSource struct {
+	client *Client
+}
+
+// AliasesDataSourceModel describes the data source data model.
+type AliasesDataSourceModel struct {
+	Database types.String `tfsdk:"database"`
+	Aliases  types.List   `tfsdk:"aliases"`
+}
+
+// AliasModel describes the database alias.
+type AliasModel struct {
+	Name      types.String `tfsdk:"name"`
+	Database  types.String `tfsdk:"database"`
+	Composite types.String `tfsdk:"composite"`
+	Location  types.String `tfsdk:"location"`
+	URL       types.String `tfsdk:"url"`
+	User      types.String `tfsdk:"user"`
+}
+
+var aliasAttrTypes = map[string]attr.Type{
+	"name":      types.StringType,
+	"database":  types.StringType,
+	"composite": types.StringType,
+	"location":  types.StringType,
+	"url":       types.StringType,
+	"user":      types.StringType,
+}
+
+const aliasesSuffix = "_aliases"
+
+func (d *AliasesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + aliasesSuffix
+}
+
+func (d *AliasesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Neo4j database aliases, details: " +
+			"https://neo4j.com/docs/operations-manual/current/database-administration/aliases/manage-aliases-standard-databases/" +
+			"\n\n!>**Warning** The data source requires the Neo4j Enterprise Edition.",
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				MarkdownDescription: "The target database to filter the aliases by. " +
+					"All aliases are returned if not set.",
+				Optional: true,
+			},
+			"aliases": schema.ListNestedAttribute{
+				MarkdownDescription: "The database aliases.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The alias name.",
+							Computed:            true,
+						},
+						"database": schema.StringAttribute{
+							MarkdownDescription: "The target database name.",
+							Computed:            true,
+						},
+						"composite": schema.StringAttribute{
+							MarkdownDescription: "The composite database the alias belongs to, if any.",
+							Computed:            true,
+						},
+						"location": schema.StringAttribute{
+							MarkdownDescription: "The alias location: `local` or `remote`.",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "The URL of the remote database.",
+							Computed:            true,
+						},
+						"user": schema.StringAttribute{
+							MarkdownDescription: "The user to connect to the remote database.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AliasesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AliasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AliasesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "reading the aliases")
+
+	var filter string
+	if !data.Database.IsNull() {
+		filter = "WHERE database = $database "
+	}
+	dbResp, err := d.client.RunSystem(ctx, `SHOW ALIASES FOR DATABASE
+YIELD name, database, composite, location, url, user `+filter+`
+RETURN name, database, composite, location, url, user
+ORDER BY name`, map[string]any{"database": data.Database.ValueString()})
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the aliases")
+		resp.Diagnostics.AddError("failed to read the aliases", err.Error())
+		return
+	}
+
+	var aliases = make([]AliasModel, len(dbResp.Records))
+	for i, rec := range dbResp.Records {
+		m := rec.AsMap()
+		aliases[i] = AliasModel{
+			Name:      toStringValue(m["name"]),
+			Database:  toStringValue(m["database"]),
+			Composite: toStringValue(m["composite"]),
+			Location:  toStringValue(m["location"]),
+			URL:       toStringValue(m["url"]),
+			User:      toStringValue(m["user"]),
+		}
+	}
+
+	var diags diag.Diagnostics
+	data.Aliases, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: aliasAttrTypes}, aliases)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the aliases", map[string]interface{}{"count": len(aliases)})
+}
+
+// toStringValue converts the value returned by the database to the nullable Terraform string.
+func toStringValue(v any) types.String {
+	if v == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(fmt.Sprintf("%v", v))
+}
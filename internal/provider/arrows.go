@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+)
+
+// The Arrows.app document, details: https://arrows.app
+// Only the graph is read on import, the styles are ignored.
+
+const (
+	importFormatAPOC   = "apoc"
+	importFormatArrows = "arrows"
+
+	// arrowsLayoutColumns is the number of the nodes in a row of the exported diagram.
+	arrowsLayoutColumns = 10
+	// arrowsLayoutSpacing is the distance between the nodes of the exported diagram.
+	arrowsLayoutSpacing = 150
+)
+
+// arrowsImportQuery defines the query to create the graph of the Arrows.app document.
+// The nodes are created first, and indexed by their ids in the document to connect them by the relationships.
+// The nodes and the relationships are given the uuid to import them to the state of the provider's resources.
+const arrowsImportQuery = `CALL apoc.load.json($url) YIELD value
+CALL {
+  WITH value
+  UNWIND coalesce(value.nodes, []) AS n
+  CALL apoc.create.node(coalesce(n.labels, []), apoc.map.merge(coalesce(n.properties, {}), {uuid: randomUUID()}))
+  YIELD node
+  RETURN apoc.map.fromPairs(collect([n.id, node])) AS nodes
+}
+UNWIND coalesce(value.relationships, []) AS r
+WITH nodes[r.fromId] AS src, nodes[r.toId] AS dst, r
+CALL apoc.create.relationship(src, r.type, apoc.map.merge(coalesce(r.properties, {}), {uuid: randomUUID()}), dst)
+YIELD rel
+RETURN count(rel)`
+
+type arrowsPosition struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+type arrowsNode struct {
+	ID         string            `json:"id"`
+	Position   arrowsPosition    `json:"position"`
+	Caption    string            `json:"caption"`
+	Labels     []string          `json:"labels"`
+	Properties map[string]string `json:"properties"`
+	Style      map[string]any    `json:"style"`
+}
+
+type arrowsRelationship struct {
+	ID         string            `json:"id"`
+	FromID     string            `json:"fromId"`
+	ToID       string            `json:"toId"`
+	Type       string            `json:"type"`
+	Properties map[string]string `json:"properties"`
+	Style      map[string]any    `json:"style"`
+}
+
+type arrowsDocument struct {
+	Nodes         []arrowsNode         `json:"nodes"`
+	Relationships []arrowsRelationship `json:"relationships"`
+	Style         map[string]any       `json:"style"`
+}
+
+// toArrows converts the snapshot to the Arrows.app document.
+// The snapshot has no layout, hence the nodes are placed on the grid in the order of their keys.
+func toArrows(s graphSnapshot) arrowsDocument {
+	var o = arrowsDocument{
+		Nodes:         make([]arrowsNode, 0, len(s.Nodes)),
+		Relationships: make([]arrowsRelationship, 0, len(s.Relationships)),
+		Style:         map[string]any{},
+	}
+	var ids = make(map[string]string, len(s.Nodes))
+	for i, v := range s.Nodes {
+		ids[v.Key] = fmt.Sprintf("n%d", i)
+		o.Nodes = append(o.Nodes, arrowsNode{
+			ID: ids[v.Key],
+			Position: arrowsPosition{
+				X: float64(i%arrowsLayoutColumns) * arrowsLayoutSpacing,
+				Y: float64(i/arrowsLayoutColumns) * arrowsLayoutSpacing,
+			},
+			Labels:     v.Labels,
+			Properties: v.Properties,
+			Style:      map[string]any{},
+		})
+	}
+	for i, v := range s.Relationships {
+		o.Relationships = append(o.Relationships, arrowsRelationship{
+			ID:         fmt.Sprintf("r%d", i),
+			FromID:     ids[v.Start],
+			ToID:       ids[v.End],
+			Type:       v.Type,
+			Properties: v.Properties,
+			Style:      map[string]any{},
+		})
+	}
+	return o
+}
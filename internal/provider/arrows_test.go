@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToArrows(t *testing.T) {
+	var s graphSnapshot
+	for _, key := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"} {
+		s.Nodes = append(s.Nodes, snapshotNode{Key: key, Labels: []string{"City"},
+			Properties: map[string]string{"name": key}})
+	}
+	s.Relationships = []snapshotRelationship{
+		{Key: "ak", Type: "ROAD", Start: "a", End: "k", Properties: map[string]string{"km": "10"}},
+	}
+
+	got := toArrows(s)
+	assert.Len(t, got.Nodes, 11)
+	assert.Equal(t, arrowsNode{
+		ID:         "n10",
+		Position:   arrowsPosition{X: 0, Y: arrowsLayoutSpacing},
+		Labels:     []string{"City"},
+		Properties: map[string]string{"name": "k"},
+		Style:      map[string]any{},
+	}, got.Nodes[10])
+	assert.Equal(t, []arrowsRelationship{
+		{ID: "r0", FromID: "n0", ToID: "n10", Type: "ROAD", Properties: map[string]string{"km": "10"},
+			Style: map[string]any{}},
+	}, got.Relationships)
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ModelAuditLog describes the settings of the audit log.
+type ModelAuditLog struct {
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Actor   types.String `tfsdk:"actor"`
+	RunID   types.String `tfsdk:"run_id"`
+}
+
+const (
+	// auditLabel is the label of the audit records.
+	auditLabel = "TerraformAudit"
+
+	auditOperationCreate = "create"
+	auditOperationUpdate = "update"
+	auditOperationDelete = "delete"
+)
+
+// auditLogBlock defines the schema of the audit log settings.
+func auditLogBlock() schema.Block {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "Set to write the audit record for every change applied by the provider, " +
+			"i.e. the node with the label `" + auditLabel + "` and the properties `actor`, `run_id`, " +
+			"`resource_type`, `resource_id`, `operation` and `applied_at`. " +
+			"The records are written to the database managed by the provider." +
+			"\n\n-> **Note** The resource's address is not known to the provider, " +
+			"hence the resource is identified by its type and its identifying attribute, e.g. the node's `id`.",
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Set to false to switch off the audit log, e.g. for the development workspaces. " +
+					"Defaults to true.",
+				Optional: true,
+			},
+			"actor": schema.StringAttribute{
+				MarkdownDescription: "The actor who applies the changes, e.g. the CI pipeline's user. " +
+					"Defaults to the provider's user.",
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"run_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the Terraform run, e.g. the CI pipeline's job ID. " +
+					"The random UUID is generated for every run of the provider if not set.",
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+		},
+	}
+}
+
+// auditLog writes the audit records of the applied changes.
+type auditLog struct {
+	actor string
+	runID string
+}
+
+// newAuditLog defines the audit log from the provider configuration, nil if it's not enabled.
+func newAuditLog(m *ModelAuditLog, user string) (*auditLog, error) {
+	if m == nil || (!m.Enabled.IsNull() && !m.Enabled.ValueBool()) {
+		return nil, nil
+	}
+	var l = &auditLog{actor: m.Actor.ValueString(), runID: m.RunID.ValueString()}
+	if l.actor == "" {
+		l.actor = user
+	}
+	if l.runID == "" {
+		id, err := uuid.NewRandom()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate the run id: %w", err)
+		}
+		l.runID = id.String()
+	}
+	return l, nil
+}
+
+// audit writes the audit record of the change unless it failed, it's no-op if the audit log is not enabled.
+// It's deferred by the resources' Create, Update and Delete, hence the identifier is read once the change is applied.
+func (c *Client) audit(ctx context.Context, diags *diag.Diagnostics, resourceType, operation string,
+	id *types.String) {
+	if c == nil || c.auditLog == nil || diags.HasError() {
+		return
+	}
+
+	var resourceID any
+	if id != nil && !id.IsNull() && !id.IsUnknown() {
+		resourceID = id.ValueString()
+	}
+	props := map[string]interface{}{"resource_type": resourceType, "operation": operation}
+	tflog.Trace(ctx, "writing the audit record", props)
+
+	if _, err := c.Run(ctx, `CREATE (a:`+auditLabel+`{
+actor: $actor, run_id: $runID, resource_type: $resourceType, resource_id: $resourceID,
+operation: $operation, applied_at: datetime()
+})`, map[string]any{
+		"actor":        c.auditLog.actor,
+		"runID":        c.auditLog.runID,
+		"resourceType": resourceType,
+		"resourceID":   resourceID,
+		"operation":    operation,
+	}); err != nil {
+		tflog.Debug(ctx, "failed to write the audit record", props)
+		diags.AddWarning("failed to write the audit record",
+			fmt.Sprintf("The %s of %s was applied, but it's not recorded in the audit log: %v",
+				operation, resourceType, err))
+	}
+}
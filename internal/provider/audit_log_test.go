@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAuditLog(t *testing.T) {
+	l, err := newAuditLog(nil, "neo4j")
+	assert.NoError(t, err)
+	assert.Nil(t, l)
+
+	l, err = newAuditLog(&ModelAuditLog{Enabled: types.BoolValue(false)}, "neo4j")
+	assert.NoError(t, err)
+	assert.Nil(t, l)
+
+	l, err = newAuditLog(&ModelAuditLog{}, "neo4j")
+	if assert.NoError(t, err) && assert.NotNil(t, l) {
+		assert.Equal(t, "neo4j", l.actor)
+		assert.Len(t, l.runID, 36)
+	}
+
+	l, err = newAuditLog(&ModelAuditLog{Actor: types.StringValue("ci"), RunID: types.StringValue("42")}, "neo4j")
+	if assert.NoError(t, err) && assert.NotNil(t, l) {
+		assert.Equal(t, &auditLog{actor: "ci", runID: "42"}, l)
+	}
+}
+
+func TestClientAudit(t *testing.T) {
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+		AuditLog:         &ModelAuditLog{RunID: types.StringValue("TestClientAudit")},
+	})
+	if err != nil {
+		t.Fatalf("could not conenct to database: %v\n", err)
+	}
+	t.Cleanup(func() { _ = c.Close(ctx) })
+
+	var diags diag.Diagnostics
+	id := types.StringValue("foo")
+	c.audit(ctx, &diags, Name+nodeSuffix, auditOperationCreate, &id)
+	assert.False(t, diags.HasError())
+
+	// the failed changes are not recorded
+	diags.AddError("failed", "failed")
+	c.audit(ctx, &diags, Name+nodeSuffix, auditOperationDelete, &id)
+
+	dbResp, err := c.Run(ctx, `MATCH (a:TerraformAudit{run_id: "TestClientAudit"})
+RETURN collect([a.actor, a.resource_type, a.resource_id, a.operation])`, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	rec, err := dbResp.Single(ctx)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []any{[]any{testDBUser, "neo4j_node", "foo", "create"}}, rec.Values[0])
+	}
+}
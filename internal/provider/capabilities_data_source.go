@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CapabilitiesDataSource{}
+
+func NewCapabilitiesDataSource() datasource.DataSource {
+	return &CapabilitiesDataSource{}
+}
+
+// CapabilitiesDataSource defines the data source to detect the installed plugins.
+type CapabilitiesDataSource struct {
+	client *Client
+}
+
+// CapabilitiesDataSourceModel describes the data source data model.
+type CapabilitiesDataSourceModel struct {
+	APOCInstalled         types.Bool   `tfsdk:"apoc_installed"`
+	APOCVersion           types.String `tfsdk:"apoc_version"`
+	APOCExtendedInstalled types.Bool   `tfsdk:"apoc_extended_installed"`
+	GDSInstalled          types.Bool   `tfsdk:"gds_installed"`
+	GDSVersion            types.String `tfsdk:"gds_version"`
+}
+
+const capabilitiesSuffix = "_capabilities"
+
+// apocExtendedProcedures lists the procedures which are only shipped with APOC Extended.
+var apocExtendedProcedures = []string{"apoc.load.jdbc", "apoc.dv.catalog.install", "apoc.mongo.find"}
+
+func (d *CapabilitiesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + capabilitiesSuffix
+}
+
+func (d *CapabilitiesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Detects whether the APOC, APOC Extended and Graph Data Science plugins are installed, " +
+			"e.g. to conditionally create the resources which depend on them.",
+		Attributes: map[string]schema.Attribute{
+			"apoc_installed": schema.BoolAttribute{
+				MarkdownDescription: "Whether APOC is installed.",
+				Computed:            true,
+			},
+			"apoc_version": schema.StringAttribute{
+				MarkdownDescription: "APOC version, null if it's not installed.",
+				Computed:            true,
+			},
+			"apoc_extended_installed": schema.BoolAttribute{
+				MarkdownDescription: "Whether APOC Extended is installed.",
+				Computed:            true,
+			},
+			"gds_installed": schema.BoolAttribute{
+				MarkdownDescription: "Whether Graph Data Science is installed.",
+				Computed:            true,
+			},
+			"gds_version": schema.StringAttribute{
+				MarkdownDescription: "Graph Data Science version, null if it's not installed.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CapabilitiesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// names lists the names returned by the query.
+func (d *CapabilitiesDataSource) names(ctx context.Context, query string, params map[string]any) ([]string, error) {
+	dbResp, err := d.client.Run(ctx, query, params)
+	if err != nil {
+		return nil, err
+	}
+	var o []string
+	var rec *neo4j.Record
+	for dbResp.NextRecord(ctx, &rec) {
+		o = append(o, fmt.Sprintf("%v", rec.Values[0]))
+	}
+	return o, dbResp.Err()
+}
+
+// version calls the function which returns the plugin version.
+func (d *CapabilitiesDataSource) version(ctx context.Context, function string) (types.String, error) {
+	// The function name cannot be passed as parameter, it's only called when it's known to exist.
+	dbResp, err := d.client.Run(ctx, "RETURN "+function+"()", nil)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	rec, err := dbResp.Single(ctx)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	return toStringValue(rec.Values[0]), nil
+}
+
+func (d *CapabilitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+	var data CapabilitiesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "reading the capabilities")
+
+	functions, err := d.names(ctx, `SHOW FUNCTIONS YIELD name WHERE name IN ["apoc.version", "gds.version"]
+RETURN name`, nil)
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the functions")
+		resp.Diagnostics.AddError("failed to read the capabilities", err.Error())
+		return
+	}
+
+	procedures, err := d.names(ctx, `SHOW PROCEDURES YIELD name WHERE name IN $names RETURN name`,
+		map[string]any{"names": apocExtendedProcedures})
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the procedures")
+		resp.Diagnostics.AddError("failed to read the capabilities", err.Error())
+		return
+	}
+
+	data.APOCInstalled = types.BoolValue(slices.Contains(functions, "apoc.version"))
+	data.APOCExtendedInstalled = types.BoolValue(len(procedures) > 0)
+	data.GDSInstalled = types.BoolValue(slices.Contains(functions, "gds.version"))
+
+	data.APOCVersion = types.StringNull()
+	if data.APOCInstalled.ValueBool() {
+		if data.APOCVersion, err = d.version(ctx, "apoc.version"); err != nil {
+			resp.Diagnostics.AddError("failed to read the APOC version", err.Error())
+			return
+		}
+	}
+
+	data.GDSVersion = types.StringNull()
+	if data.GDSInstalled.ValueBool() {
+		if data.GDSVersion, err = d.version(ctx, "gds.version"); err != nil {
+			resp.Diagnostics.AddError("failed to read the GDS version", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the capabilities")
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccCapabilitiesDataSource(t *testing.T) {
+	t.Setenv("DB_URI", testDbURI)
+	t.Setenv("DB_USER", testDBUser)
+	t.Cleanup(func() {
+		t.Setenv("DB_URI", "")
+		t.Setenv("DB_USER", "")
+	})
+
+	const dataSourceAddress = "data." + Name + capabilitiesSuffix + "._"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// the test container runs APOC Core only
+				Config: `data "neo4j_capabilities" "_" {}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("apoc_installed"),
+						knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("apoc_version"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^5\.`))),
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("apoc_extended_installed"),
+						knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("gds_installed"),
+						knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("gds_version"),
+						knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
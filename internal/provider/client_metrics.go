@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// clientMetricsLogInterval defines how often the metrics are written to the provider's logs.
+const clientMetricsLogInterval = 10 * time.Second
+
+// clientMetrics counts the operations run by the client, e.g. to debug the pool exhaustion during large applies.
+// The driver doesn't expose its connection pool, hence the operations are counted when they acquire
+// the slot within the concurrency limit.
+type clientMetrics struct {
+	// inUse is the number of the running operations.
+	inUse atomic.Int64
+	// started is the total number of the started operations.
+	started atomic.Int64
+	// waited is the number of the operations which waited for the slot because the limit was reached.
+	waited atomic.Int64
+	// failedAcquisitions is the number of the operations cancelled while waiting for the slot.
+	failedAcquisitions atomic.Int64
+
+	mu       sync.Mutex
+	loggedAt time.Time
+}
+
+// fields defines the metrics written to the logs, the idle slots are only counted if the concurrency is limited.
+func (m *clientMetrics) fields(limit int) map[string]interface{} {
+	var o = map[string]interface{}{
+		"in_use":              m.inUse.Load(),
+		"started":             m.started.Load(),
+		"waited":              m.waited.Load(),
+		"failed_acquisitions": m.failedAcquisitions.Load(),
+	}
+	if limit > 0 {
+		o["idle"] = int64(limit) - m.inUse.Load()
+	}
+	return o
+}
+
+// log writes the metrics at most once per interval unless forced, it's no-op if the metrics are not defined.
+func (m *clientMetrics) log(ctx context.Context, limit int, force bool) {
+	if m == nil {
+		return
+	}
+
+	m.mu.Lock()
+	now := time.Now()
+	if !force && now.Sub(m.loggedAt) < clientMetricsLogInterval {
+		m.mu.Unlock()
+		return
+	}
+	m.loggedAt = now
+	m.mu.Unlock()
+
+	tflog.Debug(ctx, "client metrics", m.fields(limit))
+}
+
+// waiting counts the operation which waits for the slot, it's no-op if the metrics are not defined.
+func (m *clientMetrics) waiting() {
+	if m == nil {
+		return
+	}
+	m.waited.Add(1)
+}
+
+// acquireFailed counts the acquisition cancelled while waiting for the slot.
+func (m *clientMetrics) acquireFailed() {
+	if m == nil {
+		return
+	}
+	m.failedAcquisitions.Add(1)
+}
+
+// acquired counts the started operation.
+func (m *clientMetrics) acquired() {
+	if m == nil {
+		return
+	}
+	m.started.Add(1)
+	m.inUse.Add(1)
+}
+
+// released counts the finished operation.
+func (m *clientMetrics) released() {
+	if m == nil {
+		return
+	}
+	m.inUse.Add(-1)
+}
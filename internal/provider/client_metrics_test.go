@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientMetrics(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &buf)
+
+	c := &Client{operations: make(chan struct{}, 1), metrics: &clientMetrics{}}
+	release, err := c.acquire(ctx)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = c.acquire(cancelledCtx)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	release()
+	assert.Equal(t, map[string]interface{}{
+		"in_use":              int64(0),
+		"idle":                int64(1),
+		"started":             int64(1),
+		"waited":              int64(1),
+		"failed_acquisitions": int64(1),
+	}, c.metrics.fields(cap(c.operations)))
+
+	// the metrics are logged when the operation waits for the slot, and once per interval otherwise
+	entries, err := tflogtest.MultilineJSONDecode(&buf)
+	if !assert.NoError(t, err) || !assert.Len(t, entries, 1) {
+		return
+	}
+	assert.Equal(t, "client metrics", entries[0]["@message"])
+	assert.Equal(t, float64(1), entries[0]["in_use"])
+
+	// the metrics are optional
+	_, err = (&Client{}).acquire(ctx)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CompositeQueryDataSource{}
+
+func NewCompositeQueryDataSource() datasource.DataSource {
+	return &CompositeQueryDataSource{}
+}
+
+// CompositeQueryDataSource defines the data source to read the query results from every constituent
+// of the composite database.
+type CompositeQueryDataSource struct {
+	client *Client
+}
+
+// CompositeQueryDataSourceModel describes the data source data model.
+type CompositeQueryDataSourceModel struct {
+	Composite types.String `tfsdk:"composite"`
+	Query     types.String `tfsdk:"query"`
+	Params    types.Map    `tfsdk:"params"`
+	Rows      types.List   `tfsdk:"rows"`
+}
+
+const (
+	compositeQuerySuffix = "_composite_query"
+
+	// compositeGraphColumn is the column with the name of the constituent graph the row came from.
+	compositeGraphColumn = "graph"
+)
+
+// compositeQuery wraps the query to run it against every constituent graph of the composite database.
+func compositeQuery(query string) string {
+	return fmt.Sprintf(`UNWIND graph.names() AS %[1]s
+CALL {
+  USE graph.byName(%[1]s)
+  %[2]s
+}
+RETURN %[1]s, *`, compositeGraphColumn, query)
+}
+
+func (d *CompositeQueryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + compositeQuerySuffix
+}
+
+func (d *CompositeQueryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the Cypher query results from every constituent graph of the composite database, " +
+			"e.g. to validate the shards, details: " +
+			"https://neo4j.com/docs/operations-manual/current/database-administration/composite-databases/querying-composite-databases/" +
+			"\n\n-> **Note** The query runs in the read transaction. " +
+			"It shall not use the `" + compositeGraphColumn + "` variable, and shall end with the `RETURN` clause." +
+			"\n\n!>**Warning** The data source requires the Neo4j Enterprise Edition.",
+		Attributes: map[string]schema.Attribute{
+			"composite": schema.StringAttribute{
+				MarkdownDescription: "The composite database name.",
+				Required:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Cypher query to run against every constituent graph.",
+				Required:            true,
+			},
+			"params": schema.MapAttribute{
+				MarkdownDescription: "The query parameters.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"rows": schema.ListAttribute{
+				MarkdownDescription: "The returned rows, the maps of the columns' values formatted as strings. " +
+					"The `" + compositeGraphColumn + "` column holds the name of the constituent graph the row came from.",
+				Computed:    true,
+				ElementType: types.MapType{ElemType: types.StringType},
+			},
+		},
+	}
+}
+
+func (d *CompositeQueryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CompositeQueryDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+	var data CompositeQueryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var params map[string]string
+	resp.Diagnostics.Append(data.Params.ElementsAs(ctx, &params, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var queryParams = make(map[string]any, len(params))
+	for k, v := range params {
+		queryParams[k] = v
+	}
+
+	props := map[string]interface{}{"composite": data.Composite.ValueString()}
+	tflog.Trace(ctx, "reading the composite query results", props)
+
+	dbResp, err := d.client.RunReadOn(ctx, data.Composite.ValueString(), compositeQuery(data.Query.ValueString()),
+		queryParams)
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the composite query results", props)
+		resp.Diagnostics.AddError("failed to read the composite query results", err.Error())
+		return
+	}
+
+	var rows = make([]map[string]string, len(dbResp.Records))
+	for i, rec := range dbResp.Records {
+		rows[i] = make(map[string]string, len(rec.Keys))
+		for j, k := range rec.Keys {
+			if rec.Values[j] != nil {
+				rows[i][k] = formatProperty(rec.Values[j])
+			}
+		}
+	}
+
+	var diags diag.Diagnostics
+	data.Rows, diags = types.ListValueFrom(ctx, types.MapType{ElemType: types.StringType}, rows)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the composite query results", map[string]interface{}{"count": len(rows)})
+}
@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompositeQuery(t *testing.T) {
+	assert.Equal(t, `UNWIND graph.names() AS graph
+CALL {
+  USE graph.byName(graph)
+  MATCH (n:Person) RETURN count(n) AS persons
+}
+RETURN graph, *`, compositeQuery("MATCH (n:Person) RETURN count(n) AS persons"))
+}
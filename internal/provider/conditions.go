@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// condition defines the Cypher query which returns true if the condition holds.
+type condition struct {
+	Query   types.String `tfsdk:"query"`
+	Message types.String `tfsdk:"message"`
+}
+
+// preconditionsAttribute defines the attribute of the conditions checked before the change is applied.
+var preconditionsAttribute = schema.ListNestedAttribute{
+	MarkdownDescription: "The conditions checked before the entity is created, or updated, " +
+		"e.g. to enforce the domain invariants. The change is aborted with the condition's message " +
+		"if any of them doesn't hold. The queries are run in the read transaction " +
+		"with the parameters `$id` and `$properties` of the entity.",
+	Optional: true,
+	NestedObject: schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				MarkdownDescription: "The Cypher query which returns the single boolean, true if the condition holds, " +
+					"e.g. `MATCH (c:Customer{uuid: $properties.customer}) RETURN c.active`.",
+				Required:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "The error message reported if the condition doesn't hold.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+		},
+	},
+	Validators: []validator.List{listvalidator.SizeAtLeast(1)},
+}
+
+// readConditions reads the configured conditions.
+func readConditions(ctx context.Context, v types.List) (o []condition, diags diag.Diagnostics) {
+	if v.IsNull() || v.IsUnknown() {
+		return nil, diags
+	}
+	diags.Append(v.ElementsAs(ctx, &o, false)...)
+	return o, diags
+}
+
+// conditionResult reads the condition's result from the query's records.
+func conditionResult(values []any) (bool, error) {
+	if len(values) != 1 {
+		return false, fmt.Errorf("the condition shall return a single value, got %d", len(values))
+	}
+	ok, isBool := values[0].(bool)
+	if !isBool {
+		return false, fmt.Errorf("the condition shall return the boolean, got %T", values[0])
+	}
+	return ok, nil
+}
+
+// checkPreconditions runs the conditions before the change is applied, and reports those which don't hold.
+func (c *Client) checkPreconditions(ctx context.Context, attribute types.List, id string,
+	properties map[string]any) (diags diag.Diagnostics) {
+	conditions, diags := readConditions(ctx, attribute)
+	if diags.HasError() {
+		return diags
+	}
+
+	var params = map[string]any{"id": id, "properties": properties}
+	for i, cond := range conditions {
+		p := path.Root("preconditions").AtListIndex(i)
+		dbResp, err := c.RunRead(ctx, cond.Query.ValueString(), params)
+		if err != nil {
+			diags.AddAttributeError(p, "failed to check the precondition", err.Error())
+			continue
+		}
+		var values []any
+		if len(dbResp.Records) == 1 {
+			values = dbResp.Records[0].Values
+		}
+		ok, err := conditionResult(values)
+		switch {
+		case err != nil:
+			diags.AddAttributeError(p, "failed to check the precondition", err.Error())
+		case !ok:
+			diags.AddAttributeError(p, "precondition failed", cond.Message.ValueString())
+		}
+	}
+	return diags
+}
+
+// postconditionsAttribute defines the attribute of the conditions checked after the change is applied.
+var postconditionsAttribute = schema.ListNestedAttribute{
+	MarkdownDescription: "The conditions checked after the entity is created, or updated, in the same transaction, " +
+		"e.g. like the database `CHECK` constraints. The change is rolled back with the condition's message " +
+		"if any of them doesn't hold. The queries are run with the parameters `$id` and `$properties` of the entity.",
+	Optional:     true,
+	NestedObject: preconditionsAttribute.NestedObject,
+	Validators:   []validator.List{listvalidator.SizeAtLeast(1)},
+}
+
+// runWrite executes the write query, and checks the postconditions in the same transaction.
+// The transaction is rolled back if any of the postconditions doesn't hold.
+// The write is retried if it fails by the transient error, and the write retry is configured.
+func (c *Client) runWrite(ctx context.Context, query string, params map[string]any, attribute types.List,
+	id string, properties map[string]any) (summary neo4j.ResultSummary, err error) {
+	postconditions, diags := readConditions(ctx, attribute)
+	if diags.HasError() {
+		return nil, fmt.Errorf("failed to read the postconditions: %v", diags)
+	}
+	err = c.writeRetry.do(ctx, func() (err error) {
+		summary, err = c.runWriteOnce(ctx, query, params, postconditions, id, properties)
+		return err
+	})
+	return summary, err
+}
+
+// runWriteOnce executes the write query, and checks the postconditions in the same transaction.
+// The query is run in the client's session as is if no postconditions are configured, otherwise
+// the transaction is run in the dedicated session, because the client's session is shared by the resources.
+func (c *Client) runWriteOnce(ctx context.Context, query string, params map[string]any, postconditions []condition,
+	id string, properties map[string]any) (summary neo4j.ResultSummary, err error) {
+	if len(postconditions) == 0 {
+		dbResp, err := c.Run(ctx, query, params)
+		if err != nil {
+			return nil, err
+		}
+		return dbResp.Consume(ctx)
+	}
+
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	session := c.newWriteSession(ctx)
+	defer func() {
+		ctx, cancel := cleanupContext(ctx)
+		defer cancel()
+		_ = session.Close(ctx)
+	}()
+
+	var conditionParams = map[string]any{"id": id, "properties": properties}
+	v, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		c.queryLog.log(c.database, query, params)
+		result, err := tx.Run(ctx, query, params)
+		if err != nil {
+			return nil, err
+		}
+		summary, err := result.Consume(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var failed []string
+		for i, cond := range postconditions {
+			c.queryLog.log(c.database, cond.Query.ValueString(), conditionParams)
+			result, err := tx.Run(ctx, cond.Query.ValueString(), conditionParams)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check the postcondition %d: %w", i, err)
+			}
+			rec, err := result.Single(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check the postcondition %d: %w", i, err)
+			}
+			ok, err := conditionResult(rec.Values)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check the postcondition %d: %w", i, err)
+			}
+			if !ok {
+				failed = append(failed, cond.Message.ValueString())
+			}
+		}
+		// The managed transaction is rolled back when the work returns the error.
+		if len(failed) > 0 {
+			return nil, fmt.Errorf("the change is rolled back, because the postconditions failed: %s",
+				strings.Join(failed, " "))
+		}
+		return summary, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(neo4j.ResultSummary), nil
+}
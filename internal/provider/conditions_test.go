@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConditionResult(t *testing.T) {
+	ok, err := conditionResult([]any{true})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = conditionResult([]any{false})
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	_, err = conditionResult(nil)
+	assert.ErrorContains(t, err, "single value")
+
+	_, err = conditionResult([]any{int64(1)})
+	assert.ErrorContains(t, err, "boolean")
+}
+
+// testConditions defines the conditions' attribute value.
+func testConditions(queries ...string) types.List {
+	var attrTypes = map[string]attr.Type{"query": types.StringType, "message": types.StringType}
+	var o = make([]attr.Value, len(queries))
+	for i, q := range queries {
+		o[i] = types.ObjectValueMust(attrTypes, map[string]attr.Value{
+			"query":   types.StringValue(q),
+			"message": types.StringValue(fmt.Sprintf("condition %d failed", i)),
+		})
+	}
+	return types.ListValueMust(types.ObjectType{AttrTypes: attrTypes}, o)
+}
+
+func TestClientRunWritePostconditions(t *testing.T) {
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err != nil {
+		t.Fatalf("could not connect to database: %v", err)
+	}
+	t.Cleanup(func() {
+		_, _ = c.Run(ctx, `MATCH (n:TestPostconditions) DELETE n`, nil)
+		_ = c.Close(ctx)
+	})
+
+	const query = `CREATE (n:TestPostconditions{uuid: $uuid})`
+	_, err = c.runWrite(ctx, query, map[string]any{"uuid": "failed"},
+		testConditions(`MATCH (n:TestPostconditions{uuid: $id}) RETURN n.uuid = "other"`), "failed", nil)
+	assert.ErrorContains(t, err, "condition 0 failed")
+
+	_, err = c.runWrite(ctx, query, map[string]any{"uuid": "passed"},
+		testConditions(`MATCH (n:TestPostconditions{uuid: $id}) RETURN n.uuid = $id`), "passed", nil)
+	assert.NoError(t, err)
+
+	// the write is run in the dedicated session, the client's session reads it right away
+	dbResp, err := c.Run(ctx, `MATCH (n:TestPostconditions) RETURN collect(n.uuid)`, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	rec, err := dbResp.Single(ctx)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []any{"passed"}, rec.Values[0])
+	}
+}
@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ConsistencyCheckResource{}
+var _ resource.ResourceWithImportState = &ConsistencyCheckResource{}
+
+func NewConsistencyCheckResource() resource.Resource {
+	return &ConsistencyCheckResource{}
+}
+
+// ConsistencyCheckResource defines the resource to schedule the consistency query using APOC.
+type ConsistencyCheckResource struct {
+	client *Client
+}
+
+// ConsistencyCheckResourceModel describes the resource data model.
+type ConsistencyCheckResourceModel struct {
+	Name  types.String `tfsdk:"name"`
+	Query types.String `tfsdk:"query"`
+	Rate  types.Int64  `tfsdk:"rate"`
+}
+
+const (
+	consistencyCheckSuffix = "_consistency_check"
+
+	// consistencyCheckResultLabel is the label of the well-known nodes the checks' results are written to.
+	consistencyCheckResultLabel = "ConsistencyCheckResult"
+
+	consistencyCheckDefaultRate = 3600
+)
+
+// consistencyCheckStatement defines the job's statement which counts the rows returned by the query,
+// and writes the count to the check's result node.
+func consistencyCheckStatement(query string) string {
+	return fmt.Sprintf(`CALL {
+  %s
+}
+WITH count(*) AS violations
+MERGE (c:%s{name: $name})
+SET c.violations = violations, c.checked_at = datetime()`, query, consistencyCheckResultLabel)
+}
+
+func (r *ConsistencyCheckResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + consistencyCheckSuffix
+}
+
+func (r *ConsistencyCheckResource) Schema(_ context.Context, _ resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Schedules the consistency query, e.g. to detect the orphaned nodes, " +
+			"as the APOC background job, details: " +
+			"https://neo4j.com/docs/apoc/current/background-operations/periodic-background/" +
+			"\n\nEvery row returned by the query is counted as the violation. The number of violations and " +
+			"the time of the check are written to the `" + consistencyCheckResultLabel + "` node with the check's name, " +
+			"use the data source `neo4j_consistency_check_result` to read them." +
+			"\n\n-> **Note** The background jobs are not kept when the DBMS restarts, " +
+			"the job is planned to be scheduled again in such case. The result node is deleted on destroy." +
+			"\n\n!>**Warning** The resource requires the APOC plugin.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The check's name, it's also the name of the background job.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Cypher query which returns a row per violation, " +
+					"e.g. `MATCH (n:Order) WHERE NOT (n)--(:Customer) RETURN n`. It shall end with the `RETURN` clause.",
+				Required:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"rate": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The interval between the checks in seconds. Defaults to %d.",
+					consistencyCheckDefaultRate),
+				Optional:   true,
+				Computed:   true,
+				Default:    int64default.StaticInt64(consistencyCheckDefaultRate),
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
+		},
+	}
+}
+
+func (r *ConsistencyCheckResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// schedule schedules the background job, the job with the same name is cancelled first.
+func (r *ConsistencyCheckResource) schedule(ctx context.Context, data ConsistencyCheckResourceModel) error {
+	if err := r.client.Explain(ctx, consistencyCheckStatement(data.Query.ValueString()),
+		map[string]any{"name": data.Name.ValueString()}); err != nil {
+		return fmt.Errorf("invalid query: %w", err)
+	}
+	if err := r.cancel(ctx, data.Name.ValueString()); err != nil {
+		return err
+	}
+	dbResp, err := r.client.Run(ctx, `CALL apoc.periodic.repeat($name, $statement, $rate, {params: {name: $name}})`,
+		map[string]any{
+			"name":      data.Name.ValueString(),
+			"statement": consistencyCheckStatement(data.Query.ValueString()),
+			"rate":      data.Rate.ValueInt64(),
+		})
+	if err != nil {
+		return err
+	}
+	_, err = dbResp.Consume(ctx)
+	return err
+}
+
+// cancel cancels the background job.
+func (r *ConsistencyCheckResource) cancel(ctx context.Context, name string) error {
+	dbResp, err := r.client.Run(ctx, `CALL apoc.periodic.cancel($name)`, map[string]any{"name": name})
+	if err != nil {
+		return err
+	}
+	_, err = dbResp.Consume(ctx)
+	return err
+}
+
+func (r *ConsistencyCheckResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+	var data ConsistencyCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+consistencyCheckSuffix, auditOperationCreate, &data.Name)
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "schedule the consistency check", props)
+
+	if err := r.schedule(ctx, data); err != nil {
+		tflog.Debug(ctx, "failed to schedule the consistency check", props)
+		resp.Diagnostics.AddError("failed to schedule the consistency check", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "scheduled the consistency check", props)
+}
+
+func (r *ConsistencyCheckResource) Read(ctx context.Context, req resource.ReadRequest,
+	resp *resource.ReadResponse) {
+	var data ConsistencyCheckResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "reading the consistency check", props)
+
+	dbResp, err := r.client.Run(ctx, `CALL apoc.periodic.list() YIELD name, rate, cancelled
+WHERE name = $name AND NOT cancelled
+RETURN rate`, map[string]any{"name": data.Name.ValueString()})
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the consistency check", props)
+		resp.Diagnostics.AddError("failed to read the consistency check", err.Error())
+		return
+	}
+	records, err := dbResp.Collect(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the consistency check", props)
+		resp.Diagnostics.AddError("failed to read the consistency check", err.Error())
+		return
+	}
+	if len(records) == 0 {
+		tflog.Debug(ctx, "no consistency check found", props)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if v, ok := records[0].Values[0].(int64); ok {
+		data.Rate = types.Int64Value(v)
+	}
+	// The job's statement is not listed, hence the query is kept as is.
+	if data.Query.IsNull() {
+		resp.Diagnostics.AddAttributeWarning(path.Root("query"), "unknown query",
+			"The query of the scheduled job cannot be read, it's replaced by the configured one on the next apply.")
+		data.Query = types.StringValue("")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the consistency check", props)
+}
+
+func (r *ConsistencyCheckResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+	var data ConsistencyCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+consistencyCheckSuffix, auditOperationUpdate, &data.Name)
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "update the consistency check", props)
+
+	if err := r.schedule(ctx, data); err != nil {
+		tflog.Debug(ctx, "failed to update the consistency check", props)
+		resp.Diagnostics.AddError("failed to update the consistency check", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "updated the consistency check", props)
+}
+
+func (r *ConsistencyCheckResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+	var data ConsistencyCheckResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+consistencyCheckSuffix, auditOperationDelete, &data.Name)
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "delete the consistency check", props)
+
+	if err := r.cancel(ctx, data.Name.ValueString()); err != nil {
+		tflog.Debug(ctx, "failed to cancel the consistency check", props)
+		resp.Diagnostics.AddError("failed to cancel the consistency check", err.Error())
+		return
+	}
+	dbResp, err := r.client.Run(ctx, fmt.Sprintf(`MATCH (c:%s{name: $name}) DELETE c`, consistencyCheckResultLabel),
+		map[string]any{"name": data.Name.ValueString()})
+	if err == nil {
+		_, err = dbResp.Consume(ctx)
+	}
+	if err != nil {
+		tflog.Debug(ctx, "failed to delete the consistency check result", props)
+		resp.Diagnostics.AddError("failed to delete the consistency check result", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "deleted the consistency check", props)
+}
+
+func (r *ConsistencyCheckResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
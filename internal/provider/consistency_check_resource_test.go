@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConsistencyCheckStatement(t *testing.T) {
+	assert.Equal(t, `CALL {
+  MATCH (n:Order) WHERE NOT (n)--() RETURN n
+}
+WITH count(*) AS violations
+MERGE (c:ConsistencyCheckResult{name: $name})
+SET c.violations = violations, c.checked_at = datetime()`,
+		consistencyCheckStatement("MATCH (n:Order) WHERE NOT (n)--() RETURN n"))
+}
+
+func TestAccConsistencyCheckResource(t *testing.T) {
+	t.Setenv("DB_URI", testDbURI)
+	t.Setenv("DB_USER", testDBUser)
+	t.Cleanup(func() {
+		t.Setenv("DB_URI", "")
+		t.Setenv("DB_USER", "")
+	})
+
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err != nil {
+		t.Errorf("could not conenct to database: %v\n", err)
+		return
+	}
+	t.Cleanup(func() { _ = c.Close(ctx) })
+	t.Cleanup(func() {
+		_, _ = c.Run(ctx, `MATCH (n:ConsistencyCheckOrphan) DETACH DELETE n`, nil)
+	})
+
+	if _, err := c.Run(ctx, `UNWIND range(1, 2) AS id CREATE (:ConsistencyCheckOrphan{id: id})`,
+		nil); err != nil {
+		t.Errorf("could not create the nodes: %v\n", err)
+		return
+	}
+
+	const (
+		resourceAddress   = Name + consistencyCheckSuffix + "._"
+		dataSourceAddress = "data." + Name + consistencyCheckResultSuffix + "._"
+		config            = `resource "neo4j_consistency_check" "_" {
+name  = "orphans"
+query = "MATCH (n:ConsistencyCheckOrphan) WHERE NOT (n)--() RETURN n"
+rate  = 1
+}`
+	)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr(resourceAddress, "rate", "1"),
+			},
+			{
+				// the job runs in the background
+				PreConfig: func() { time.Sleep(2 * time.Second) },
+				Config: config + `
+data "neo4j_consistency_check_result" "_" {
+name = neo4j_consistency_check._.name
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceAddress, "violations", "2"),
+					resource.TestCheckResourceAttrSet(dataSourceAddress, "checked_at"),
+				),
+			},
+			{
+				ResourceName:                         resourceAddress,
+				ImportState:                          true,
+				ImportStateId:                        "orphans",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"query"},
+			},
+		},
+	})
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ConsistencyCheckResultDataSource{}
+
+func NewConsistencyCheckResultDataSource() datasource.DataSource {
+	return &ConsistencyCheckResultDataSource{}
+}
+
+// ConsistencyCheckResultDataSource defines the data source to read the result of the scheduled consistency check.
+type ConsistencyCheckResultDataSource struct {
+	client *Client
+}
+
+// ConsistencyCheckResultDataSourceModel describes the data source data model.
+type ConsistencyCheckResultDataSourceModel struct {
+	Name       types.String `tfsdk:"name"`
+	Violations types.Int64  `tfsdk:"violations"`
+	CheckedAt  types.String `tfsdk:"checked_at"`
+}
+
+const consistencyCheckResultSuffix = "_consistency_check_result"
+
+func (d *ConsistencyCheckResultDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + consistencyCheckResultSuffix
+}
+
+func (d *ConsistencyCheckResultDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the latest result of the consistency check scheduled by " +
+			"the resource `neo4j_consistency_check`, e.g. to assert it in the `check` blocks.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The check's name.",
+				Required:            true,
+			},
+			"violations": schema.Int64Attribute{
+				MarkdownDescription: "The number of violations found by the latest check, null if it hasn't run yet.",
+				Computed:            true,
+			},
+			"checked_at": schema.StringAttribute{
+				MarkdownDescription: "The time of the latest check in RFC 3339 format, null if it hasn't run yet.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ConsistencyCheckResultDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ConsistencyCheckResultDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+	var data ConsistencyCheckResultDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "reading the consistency check result", props)
+
+	dbResp, err := d.client.RunRead(ctx, fmt.Sprintf(`MATCH (c:%s{name: $name})
+RETURN c.violations, c.checked_at`, consistencyCheckResultLabel), map[string]any{"name": data.Name.ValueString()})
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the consistency check result", props)
+		resp.Diagnostics.AddError("failed to read the consistency check result", err.Error())
+		return
+	}
+
+	data.Violations = types.Int64Null()
+	data.CheckedAt = types.StringNull()
+	if len(dbResp.Records) > 0 {
+		rec := dbResp.Records[0]
+		if v, ok := rec.Values[0].(int64); ok {
+			data.Violations = types.Int64Value(v)
+		}
+		if v, ok := rec.Values[1].(time.Time); ok {
+			data.CheckedAt = types.StringValue(v.Format(time.RFC3339))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the consistency check result", props)
+}
@@ -0,0 +1,611 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CypherScriptResource{}
+var _ resource.ResourceWithModifyPlan = &CypherScriptResource{}
+
+func NewCypherScriptResource() resource.Resource {
+	return &CypherScriptResource{}
+}
+
+// CypherScriptResource defines the resource to run the Cypher script file.
+type CypherScriptResource struct {
+	client *Client
+}
+
+// CypherScriptResourceModel describes the resource data model.
+type CypherScriptResourceModel struct {
+	File           types.String `tfsdk:"file"`
+	ParameterFiles types.List   `tfsdk:"parameter_files"`
+	ReadQuery      types.String `tfsdk:"read_query"`
+	ValidateOnPlan types.Bool   `tfsdk:"validate_on_plan"`
+	Checksum       types.String `tfsdk:"checksum"`
+	Statements     types.Int64  `tfsdk:"statements"`
+}
+
+const cypherScriptSuffix = "_cypher_script"
+
+// scriptStep is the step of the Cypher script: the statement, or the parameter set with the `:param` command.
+type scriptStep struct {
+	// query is the statement, or the expression of the parameter's value.
+	query string
+	// param is the name of the parameter, the expression shall return the map of the parameters if it's empty.
+	param   string
+	isParam bool
+}
+
+// scriptCommand reads the cypher-shell command, only `:param` is supported.
+func scriptCommand(line string) (scriptStep, error) {
+	line = strings.TrimSuffix(strings.TrimSpace(line), ";")
+	name, rest, _ := strings.Cut(line, " ")
+	if name != ":param" && name != ":params" {
+		return scriptStep{}, fmt.Errorf("unsupported command %s, only :param is supported", name)
+	}
+
+	rest = strings.TrimSpace(rest)
+	if strings.HasPrefix(rest, "{") {
+		return scriptStep{query: rest, isParam: true}, nil
+	}
+	// Both the current `name => expression`, and the legacy `name: expression` syntax are supported.
+	param, expr, ok := strings.Cut(rest, "=>")
+	if !ok {
+		param, expr, ok = strings.Cut(rest, ":")
+	}
+	param, expr = strings.Trim(strings.TrimSpace(param), "`"), strings.TrimSpace(expr)
+	if !ok || param == "" || expr == "" {
+		return scriptStep{}, fmt.Errorf("invalid command %s, expected :param name => expression", line)
+	}
+	return scriptStep{query: expr, param: param, isParam: true}, nil
+}
+
+// parseCypherScript splits the script to the statements by the semicolons outside the strings,
+// the quoted names and the comments. The comments are removed.
+func parseCypherScript(script string) (o []scriptStep, err error) {
+	var (
+		statement strings.Builder
+		quote     rune
+		runes     = []rune(script)
+	)
+
+	flush := func() {
+		if v := strings.TrimSpace(statement.String()); v != "" {
+			o = append(o, scriptStep{query: v})
+		}
+		statement.Reset()
+	}
+
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		var next rune
+		if i+1 < len(runes) {
+			next = runes[i+1]
+		}
+
+		switch {
+		case quote != 0:
+			statement.WriteRune(c)
+			switch {
+			case c == '\\' && quote != '`' && next != 0:
+				statement.WriteRune(next)
+				i++
+			case c == quote:
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+			statement.WriteRune(c)
+		case c == '/' && next == '/':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+			statement.WriteRune('\n')
+		case c == '/' && next == '*':
+			end := i + 2
+			for end+1 < len(runes) && (runes[end] != '*' || runes[end+1] != '/') {
+				end++
+			}
+			if end+1 >= len(runes) {
+				return nil, errors.New("unterminated comment")
+			}
+			i = end + 1
+			statement.WriteRune(' ')
+		case c == ':' && strings.TrimSpace(statement.String()) == "":
+			end := i
+			for end < len(runes) && runes[end] != '\n' {
+				end++
+			}
+			step, err := scriptCommand(string(runes[i:end]))
+			if err != nil {
+				return nil, err
+			}
+			o = append(o, step)
+			statement.Reset()
+			i = end
+		case c == ';':
+			flush()
+		default:
+			statement.WriteRune(c)
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quote %c", quote)
+	}
+	flush()
+	return o, nil
+}
+
+// normalizeParameter converts the JSON numbers to the integers, or the floats.
+// The integers would be stored as the floats otherwise.
+func normalizeParameter(v any) any {
+	switch vv := v.(type) {
+	case json.Number:
+		if i, err := vv.Int64(); err == nil {
+			return i
+		}
+		f, _ := vv.Float64()
+		return f
+	case map[string]any:
+		for k, el := range vv {
+			vv[k] = normalizeParameter(el)
+		}
+	case []any:
+		for i, el := range vv {
+			vv[i] = normalizeParameter(el)
+		}
+	}
+	return v
+}
+
+// readParameters reads the parameters map from the JSON, or YAML file content.
+func readParameters(file string, content []byte) (o map[string]any, err error) {
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.UseNumber()
+		err = decoder.Decode(&o)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(content, &o)
+	default:
+		return nil, fmt.Errorf("unsupported parameter file %s, expected .json, .yaml, or .yml", file)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the parameter file %s: %w", file, err)
+	}
+	normalizeParameter(o)
+	return o, nil
+}
+
+// readFiles reads the files, and calculates SHA-256 checksum of their contents.
+func readFiles(files []string) (contents [][]byte, checksum string, err error) {
+	h := sha256.New()
+	for _, file := range files {
+		o, err := os.ReadFile(file)
+		if err != nil {
+			return nil, "", err
+		}
+		// The files' digests are hashed to distinguish the content moved from one file to another.
+		digest := sha256.Sum256(o)
+		h.Write(digest[:])
+		contents = append(contents, o)
+	}
+	return contents, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// mergeParameters reads the parameter files' contents, the parameters of the later files override the former.
+func mergeParameters(files []string, contents [][]byte) (params map[string]any, diags diag.Diagnostics) {
+	params = map[string]any{}
+	for i, content := range contents {
+		values, err := readParameters(files[i], content)
+		if err != nil {
+			diags.AddAttributeError(path.Root("parameter_files").AtListIndex(i),
+				"invalid parameter file", err.Error())
+			return nil, diags
+		}
+		for k, v := range values {
+			params[k] = v
+		}
+	}
+	return params, diags
+}
+
+// scriptFiles lists the script file followed by its parameter files.
+func scriptFiles(ctx context.Context, data *CypherScriptResourceModel) (files []string, diags diag.Diagnostics) {
+	if !data.ParameterFiles.IsNull() && !data.ParameterFiles.IsUnknown() {
+		diags.Append(data.ParameterFiles.ElementsAs(ctx, &files, false)...)
+	}
+	return append([]string{data.File.ValueString()}, files...), diags
+}
+
+func (r *CypherScriptResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + cypherScriptSuffix
+}
+
+func (r *CypherScriptResource) Schema(_ context.Context, _ resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Runs the Cypher script file, e.g. to reuse the existing migration scripts. " +
+			"The script is split to the statements by semicolons, and every statement is run " +
+			"in its own transaction, like by `cypher-shell`. The parameters set with the `:param` command " +
+			"are passed to the following statements, other `cypher-shell` commands are not supported." +
+			"\n\n-> **Note** The script is run again when its checksum, or the checksum of its parameter files changes. " +
+			"The script's changes are kept when the resource is destroyed." +
+			"\n\n!>**Warning** The statements committed before the failed statement are not rolled back, " +
+			"hence the script shall be idempotent, e.g. use `MERGE` and `IF NOT EXISTS`.",
+		Attributes: map[string]schema.Attribute{
+			"file": schema.StringAttribute{
+				MarkdownDescription: "The path to the `.cypher` script file.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"parameter_files": schema.ListAttribute{
+				MarkdownDescription: "The paths to the JSON, or YAML files with the map of the parameters " +
+					"passed to the script's statements, e.g. to keep the large payloads out of the configuration. " +
+					"The parameters of the later files override the parameters of the former, " +
+					"and the parameters set with the `:param` command override the files' parameters.",
+				Optional:      true,
+				ElementType:   types.StringType,
+				Validators:    []validator.List{listvalidator.SizeAtLeast(1)},
+				PlanModifiers: []planmodifier.List{listplanmodifier.RequiresReplace()},
+			},
+			"read_query": schema.StringAttribute{
+				MarkdownDescription: "The read Cypher query which returns the single boolean, true if the script's changes " +
+					"are in the database, e.g. `MATCH (c:Country) RETURN count(c) > 0`. The query is run with " +
+					"the parameters of the `parameter_files`. If set, the script is not run when the query returns true, " +
+					"and the resource is recreated when the query returns false, e.g. because the changes were deleted " +
+					"outside Terraform.",
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"validate_on_plan": schema.BoolAttribute{
+				MarkdownDescription: "Set to validate the script's statements with `EXPLAIN` when the plan is made, " +
+					"before the script is run. The `:param` commands are not validated. " +
+					"Disable it when the database is not reachable at plan time.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"checksum": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 checksum of the run script and its parameter files.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"statements": schema.Int64Attribute{
+				MarkdownDescription: "The number of the run statements, zero if the script was not run " +
+					"because the `read_query` returned true.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *CypherScriptResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CypherScriptResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan CypherScriptResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The script is only run when the resource is created, or replaced.
+	if !req.State.Raw.IsNull() {
+		var state CypherScriptResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || !planChecksum(ctx, plan, state, resp) {
+			return
+		}
+	}
+
+	if plan.ValidateOnPlan.ValueBool() && r.client != nil &&
+		!plan.File.IsUnknown() && !plan.ParameterFiles.IsUnknown() {
+		resp.Diagnostics.Append(r.explainScript(ctx, &plan)...)
+	}
+}
+
+// planChecksum compares the checksum of the script and its parameter files to the state's one,
+// and plans the replacement if it changed. It returns true if the resource is replaced.
+func planChecksum(ctx context.Context, plan, state CypherScriptResourceModel,
+	resp *resource.ModifyPlanResponse) bool {
+	// The changed files replace the resource by their plan modifiers.
+	if plan.File.IsUnknown() || !plan.File.Equal(state.File) ||
+		plan.ParameterFiles.IsUnknown() || !plan.ParameterFiles.Equal(state.ParameterFiles) {
+		return true
+	}
+
+	files, diags := scriptFiles(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return false
+	}
+	_, checksum, err := readFiles(files)
+	if err != nil {
+		resp.Diagnostics.AddWarning("failed to calculate the script's checksum",
+			fmt.Sprintf("the changes of the script cannot be detected: %v", err))
+		return false
+	}
+
+	if checksum == state.Checksum.ValueString() {
+		return false
+	}
+	tflog.Debug(ctx, "the script changed", map[string]interface{}{"file": plan.File.ValueString()})
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("checksum"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("statements"), types.Int64Unknown())...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("checksum"))
+	return true
+}
+
+// explainScript validates the script's statements with EXPLAIN. The `:param` commands are skipped,
+// because their values are only set when the script runs.
+func (r *CypherScriptResource) explainScript(ctx context.Context, data *CypherScriptResourceModel) diag.Diagnostics {
+	files, diags := scriptFiles(ctx, data)
+	if diags.HasError() {
+		return diags
+	}
+	contents, _, err := readFiles(files)
+	if err != nil {
+		diags.AddWarning("failed to validate the script",
+			fmt.Sprintf("the script cannot be read when the plan is made: %v", err))
+		return diags
+	}
+	steps, err := parseCypherScript(string(contents[0]))
+	if err != nil {
+		diags.AddAttributeError(path.Root("file"), "failed to parse the script", err.Error())
+		return diags
+	}
+	params, d := mergeParameters(files[1:], contents[1:])
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var statement int
+	for _, step := range steps {
+		if step.isParam {
+			continue
+		}
+		statement++
+		if err := r.client.Explain(ctx, step.query, params); err != nil {
+			diags.AddAttributeError(path.Root("file"), "invalid statement",
+				fmt.Sprintf("statement %d failed the validation: %v", statement, err))
+		}
+	}
+	return diags
+}
+
+// isApplied runs the read query to check if the script's changes are in the database.
+func (r *CypherScriptResource) isApplied(ctx context.Context, query string, params map[string]any) (bool, error) {
+	dbResp, err := r.client.RunRead(ctx, query, params)
+	if err != nil {
+		return false, err
+	}
+	var values []any
+	if len(dbResp.Records) == 1 {
+		values = dbResp.Records[0].Values
+	}
+	return conditionResult(values)
+}
+
+// runScript runs the script's statements one by one, and returns the number of the run statements.
+// The params are the initial parameters, they are changed by the script's `:param` commands.
+func (r *CypherScriptResource) runScript(ctx context.Context, steps []scriptStep,
+	params map[string]any) (int64, error) {
+	var statements int64
+	for _, step := range steps {
+		if !step.isParam {
+			dbResp, err := r.client.Run(ctx, step.query, params)
+			if err != nil {
+				return statements, fmt.Errorf("statement %d failed: %w", statements+1, err)
+			}
+			if _, err := dbResp.Consume(ctx); err != nil {
+				return statements, fmt.Errorf("statement %d failed: %w", statements+1, err)
+			}
+			statements++
+			continue
+		}
+
+		// The parameter's expression is evaluated with the parameters set before it.
+		dbResp, err := r.client.RunRead(ctx, "RETURN "+step.query+" AS value", params)
+		if err != nil {
+			return statements, fmt.Errorf("failed to set the parameter %s: %w", step.param, err)
+		}
+		if len(dbResp.Records) != 1 {
+			return statements, fmt.Errorf("failed to set the parameter %s: expected a single value", step.param)
+		}
+		value := dbResp.Records[0].Values[0]
+		if step.param != "" {
+			params[step.param] = value
+			continue
+		}
+		values, ok := value.(map[string]any)
+		if !ok {
+			return statements, fmt.Errorf("expected the map of the parameters, got %T", value)
+		}
+		for k, v := range values {
+			params[k] = v
+		}
+	}
+	return statements, nil
+}
+
+func (r *CypherScriptResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+	var data CypherScriptResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+cypherScriptSuffix, auditOperationCreate, &data.File)
+
+	props := map[string]interface{}{"file": data.File.ValueString()}
+	tflog.Trace(ctx, "run the cypher script", props)
+
+	files, diags := scriptFiles(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	contents, checksum, err := readFiles(files)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read the script", err.Error())
+		return
+	}
+	steps, err := parseCypherScript(string(contents[0]))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("file"), "failed to parse the script", err.Error())
+		return
+	}
+	params, diags := mergeParameters(files[1:], contents[1:])
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Checksum = types.StringValue(checksum)
+	if !data.ReadQuery.IsNull() {
+		applied, err := r.isApplied(ctx, data.ReadQuery.ValueString(), params)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("read_query"), "failed to run the read query", err.Error())
+			return
+		}
+		if applied {
+			tflog.Debug(ctx, "the cypher script's changes exist, the script is not run", props)
+			data.Statements = types.Int64Value(0)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	statements, err := r.runScript(ctx, steps, params)
+	if err != nil {
+		tflog.Debug(ctx, "failed to run the cypher script", props)
+		resp.Diagnostics.AddError("failed to run the cypher script",
+			fmt.Sprintf("%v, %d statements were committed before the failure", err, statements))
+		return
+	}
+
+	data.Statements = types.Int64Value(statements)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "ran the cypher script", props)
+}
+
+func (r *CypherScriptResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The script is run once, its changes are detected when the plan is modified,
+	// and its drift is detected by the read query if it's set.
+	var data CypherScriptResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ReadQuery.IsNull() {
+		files, diags := scriptFiles(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		contents, _, err := readFiles(files[1:])
+		if err != nil {
+			resp.Diagnostics.AddError("failed to read the parameter files", err.Error())
+			return
+		}
+		params, diags := mergeParameters(files[1:], contents)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		applied, err := r.isApplied(ctx, data.ReadQuery.ValueString(), params)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("read_query"), "failed to run the read query", err.Error())
+			return
+		}
+		if !applied {
+			tflog.Debug(ctx, "the cypher script's changes not found", map[string]interface{}{
+				"file": data.File.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CypherScriptResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+	// The read query only affects the drift detection, the validation only affects the plan,
+	// and the other attributes require the replacement, hence the plan is only copied to the state.
+	var data CypherScriptResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CypherScriptResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// The script's changes are kept.
+}
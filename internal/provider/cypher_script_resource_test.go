@@ -0,0 +1,328 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCypherScript(t *testing.T) {
+	tests := []struct {
+		name    string
+		script  string
+		want    []scriptStep
+		wantErr bool
+	}{
+		{
+			name: "statements and comments",
+			script: `// the first migration
+CREATE (:Migration{note: "a;b // not a comment"}); /* the block; comment */
+MATCH (n:` + "`Odd;Label`" + `) RETURN n
+;`,
+			want: []scriptStep{
+				{query: `CREATE (:Migration{note: "a;b // not a comment"})`},
+				{query: "MATCH (n:`Odd;Label`) RETURN n"},
+			},
+		},
+		{
+			name:   "escaped quote",
+			script: `RETURN 'it\'s;'`,
+			want:   []scriptStep{{query: `RETURN 'it\'s;'`}},
+		},
+		{
+			name: "parameters",
+			script: `:param name => "Alice"
+:param age: 42;
+:param {city: "Berlin"}
+CREATE (:Person{name: $name, age: $age, city: $city});`,
+			want: []scriptStep{
+				{query: `"Alice"`, param: "name", isParam: true},
+				{query: "42", param: "age", isParam: true},
+				{query: `{city: "Berlin"}`, isParam: true},
+				{query: "CREATE (:Person{name: $name, age: $age, city: $city})"},
+			},
+		},
+		{
+			name:    "unsupported command",
+			script:  ":begin\nRETURN 1;\n:commit",
+			wantErr: true,
+		},
+		{
+			name:    "unterminated quote",
+			script:  "RETURN 'a;",
+			wantErr: true,
+		},
+		{
+			name:    "unterminated comment",
+			script:  "RETURN 1 /* a;",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCypherScript(tt.script)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReadParameters(t *testing.T) {
+	got, err := readParameters("params.json", []byte(`{"count": 2, "ratio": 0.5, "tags": [1, "a"], "meta": {"n": 3}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"count": int64(2),
+		"ratio": 0.5,
+		"tags":  []any{int64(1), "a"},
+		"meta":  map[string]any{"n": int64(3)},
+	}, got)
+
+	got, err = readParameters("params.YAML", []byte("count: 2\nnames:\n  - a\n  - b\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"count": 2, "names": []any{"a", "b"}}, got)
+
+	_, err = readParameters("params.toml", []byte(`count = 2`))
+	assert.Error(t, err)
+
+	_, err = readParameters("params.json", []byte(`[1, 2]`))
+	assert.Error(t, err)
+}
+
+func TestAccCypherScriptResource(t *testing.T) {
+	t.Setenv("DB_URI", testDbURI)
+	t.Setenv("DB_USER", testDBUser)
+	t.Cleanup(func() {
+		t.Setenv("DB_URI", "")
+		t.Setenv("DB_USER", "")
+	})
+
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err != nil {
+		t.Errorf("could not conenct to database: %v\n", err)
+		return
+	}
+	t.Cleanup(func() { _ = c.Close(ctx) })
+	t.Cleanup(func() {
+		_, _ = c.Run(ctx, `MATCH (n:CypherScript) DETACH DELETE n`, nil)
+	})
+
+	dir := t.TempDir()
+	file, parameterFile := filepath.Join(dir, "migration.cypher"), filepath.Join(dir, "params.yaml")
+	writeFile := func(file, content string) {
+		if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+			t.Fatalf("could not write the file: %v", err)
+		}
+	}
+	writeScript := func(script string) { writeFile(file, script) }
+
+	// checkCreated checks the number of created nodes.
+	checkCreated := func(want int64) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			r, err := c.Run(ctx, `MATCH (n:CypherScript) RETURN count(n)`, nil)
+			if err != nil {
+				return err
+			}
+			rec, err := r.Single(ctx)
+			if err != nil {
+				return err
+			}
+			if got := rec.Values[0].(int64); got != want {
+				return fmt.Errorf("expected %d created nodes, got %d", want, got)
+			}
+			return nil
+		}
+	}
+
+	const resourceAddress = Name + cypherScriptSuffix + "._"
+	config := fmt.Sprintf(`resource "neo4j_cypher_script" "_" {
+file = %q
+}`, file)
+	configWithParameters := fmt.Sprintf(`resource "neo4j_cypher_script" "_" {
+file            = %q
+parameter_files = [%q]
+}`, file, parameterFile)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					writeScript(`:param names => ["a", "b"]
+UNWIND $names AS name MERGE (:CypherScript{name: name});
+MERGE (:CypherScript{name: "c"});`)
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceAddress, "statements", "2"),
+					resource.TestCheckResourceAttrSet(resourceAddress, "checksum"),
+					checkCreated(3),
+				),
+			},
+			// the changed script is run again
+			{
+				PreConfig: func() {
+					writeScript(`MERGE (:CypherScript{name: "d"});`)
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceAddress, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceAddress, "statements", "1"),
+					checkCreated(4),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeScript(`MERGE (:CypherScript{name: "e"});
+RETURN 1/0;`)
+				},
+				Config:      config,
+				ExpectError: regexp.MustCompile(`statement 2 failed`),
+			},
+			// the syntax errors are caught when the plan is made, hence no statement is run
+			{
+				PreConfig: func() {
+					writeScript(`MERGE (:CypherScript{name: "h"});
+:param name => "i"
+MERGE (:CypherScript{name: $name);`)
+				},
+				Config:      config,
+				ExpectError: regexp.MustCompile(`(?s)invalid statement.*statement 2 failed the validation`),
+			},
+			// the changed parameter file triggers the script
+			{
+				PreConfig: func() {
+					writeScript(`UNWIND $names AS name MERGE (:CypherScript{name: name});`)
+					writeFile(parameterFile, "names: [f]\n")
+				},
+				Config: configWithParameters,
+				Check:  checkCreated(6),
+			},
+			{
+				PreConfig: func() { writeFile(parameterFile, "names: [f, g]\n") },
+				Config:    configWithParameters,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceAddress, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: checkCreated(7),
+			},
+		},
+	})
+}
+
+func TestAccCypherScriptResourceReadQuery(t *testing.T) {
+	t.Setenv("DB_URI", testDbURI)
+	t.Setenv("DB_USER", testDBUser)
+	t.Cleanup(func() {
+		t.Setenv("DB_URI", "")
+		t.Setenv("DB_USER", "")
+	})
+
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err != nil {
+		t.Errorf("could not conenct to database: %v\n", err)
+		return
+	}
+	t.Cleanup(func() { _ = c.Close(ctx) })
+	t.Cleanup(func() {
+		_, _ = c.Run(ctx, `MATCH (n:CypherScriptReadQuery) DETACH DELETE n`, nil)
+	})
+
+	// The script is not idempotent to verify that it's not run when its changes exist.
+	file := filepath.Join(t.TempDir(), "migration.cypher")
+	if err := os.WriteFile(file, []byte(`CREATE (:CypherScriptReadQuery);`), 0o600); err != nil {
+		t.Fatalf("could not write the script: %v", err)
+	}
+
+	checkCreated := func(want int64) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			r, err := c.Run(ctx, `MATCH (n:CypherScriptReadQuery) RETURN count(n)`, nil)
+			if err != nil {
+				return err
+			}
+			rec, err := r.Single(ctx)
+			if err != nil {
+				return err
+			}
+			if got := rec.Values[0].(int64); got != want {
+				return fmt.Errorf("expected %d created nodes, got %d", want, got)
+			}
+			return nil
+		}
+	}
+
+	const resourceAddress = Name + cypherScriptSuffix + "._"
+	config := fmt.Sprintf(`resource "neo4j_cypher_script" "_" {
+file       = %q
+read_query = "MATCH (n:CypherScriptReadQuery) RETURN count(n) > 0"
+}`, file)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the script is not run, because its changes exist
+			{
+				PreConfig: func() {
+					if _, err := c.Run(ctx, `CREATE (:CypherScriptReadQuery)`, nil); err != nil {
+						t.Fatalf("could not create the node: %v", err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceAddress, "statements", "0"),
+					checkCreated(1),
+				),
+			},
+			// the script is run again, because its changes were deleted
+			{
+				PreConfig: func() {
+					if _, err := c.Run(ctx, `MATCH (n:CypherScriptReadQuery) DELETE n`, nil); err != nil {
+						t.Fatalf("could not delete the node: %v", err)
+					}
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceAddress, plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceAddress, "statements", "1"),
+					checkCreated(1),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DatabaseAliasResource{}
+var _ resource.ResourceWithImportState = &DatabaseAliasResource{}
+
+func NewDatabaseAliasResource() resource.Resource {
+	return &DatabaseAliasResource{}
+}
+
+// DatabaseAliasResource defines the resource to manage the database alias.
+type DatabaseAliasResource struct {
+	client *Client
+}
+
+// DatabaseAliasResourceModel describes the resource data model.
+type DatabaseAliasResourceModel struct {
+	Name       types.String `tfsdk:"name"`
+	Database   types.String `tfsdk:"database"`
+	Properties types.Map    `tfsdk:"properties"`
+}
+
+const databaseAliasSuffix = "_database_alias"
+
+// aliasProperties defines the map literal of the alias properties, and its parameters.
+// The properties are set by the parameters, since the command doesn't accept the map parameter.
+func aliasProperties(properties map[string]string) (string, map[string]any) {
+	var values = make(map[string]any, len(properties))
+	for k, v := range properties {
+		values[k] = v
+	}
+	return mapLiteral("property", values)
+}
+
+// mapLiteral defines the map literal of the values set by the parameters prefixed by the param.
+func mapLiteral(param string, values map[string]any) (string, map[string]any) {
+	var keys = make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	var o = make([]string, len(keys))
+	var params = make(map[string]any, len(keys))
+	for i, k := range keys {
+		name := fmt.Sprintf("%s%d", param, i)
+		o[i] = quoteName(k) + ": $" + name
+		params[name] = values[k]
+	}
+	return "{" + strings.Join(o, ", ") + "}", params
+}
+
+func (r *DatabaseAliasResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + databaseAliasSuffix
+}
+
+func (r *DatabaseAliasResource) Schema(_ context.Context, _ resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the local alias of the database, details: " +
+			"https://neo4j.com/docs/operations-manual/current/database-administration/aliases/manage-aliases-standard-databases/" +
+			"\n\n!>**Warning** The resource requires the Neo4j Enterprise Edition.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the alias. The alias is re-created if it's renamed.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"database": schema.StringAttribute{
+				MarkdownDescription: "The name of the target database.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"properties": schema.MapAttribute{
+				MarkdownDescription: "The properties of the alias, e.g. to describe its purpose.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *DatabaseAliasResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// readAliasProperties reads the configured properties, the empty map is used if they're not set.
+func readAliasProperties(ctx context.Context, v types.Map) (map[string]string, diag.Diagnostics) {
+	var o = map[string]string{}
+	if v.IsNull() {
+		return o, nil
+	}
+	diags := v.ElementsAs(ctx, &o, false)
+	return o, diags
+}
+
+// aliasPropertiesValue defines the state of the alias properties read from the database.
+// The properties are kept null if they're not configured, and the alias has none.
+func aliasPropertiesValue(ctx context.Context, current types.Map, properties map[string]any) (types.Map,
+	diag.Diagnostics) {
+	if current.IsNull() && len(properties) == 0 {
+		return current, nil
+	}
+	var o = make(map[string]string, len(properties))
+	for k, v := range properties {
+		o[k] = formatProperty(v)
+	}
+	return types.MapValueFrom(ctx, types.StringType, o)
+}
+
+func (r *DatabaseAliasResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+	var data DatabaseAliasResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+databaseAliasSuffix, auditOperationCreate, &data.Name)
+
+	properties, diags := readAliasProperties(ctx, data.Properties)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	props := map[string]interface{}{"name": data.Name.ValueString(), "database": data.Database.ValueString()}
+	tflog.Trace(ctx, "create the database alias", props)
+
+	query := `CREATE ALIAS $name FOR DATABASE $database`
+	literal, params := aliasProperties(properties)
+	if len(properties) > 0 {
+		query += " PROPERTIES " + literal
+	}
+	params["name"], params["database"] = data.Name.ValueString(), data.Database.ValueString()
+	if _, err := r.client.RunSystem(ctx, query, params); err != nil {
+		tflog.Debug(ctx, "failed to create the database alias", props)
+		resp.Diagnostics.AddError("failed to create the database alias", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "created the database alias", props)
+}
+
+func (r *DatabaseAliasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DatabaseAliasResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "reading the database alias", props)
+
+	dbResp, err := r.client.RunSystem(ctx, `SHOW ALIASES FOR DATABASE
+YIELD name, database, location, properties
+WHERE name = $name AND location = "local"
+RETURN database, properties`, map[string]any{"name": data.Name.ValueString()})
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the database alias", props)
+		resp.Diagnostics.AddError("failed to read the database alias", err.Error())
+		return
+	}
+	if len(dbResp.Records) == 0 {
+		tflog.Debug(ctx, "no database alias found", props)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	rec := dbResp.Records[0]
+	data.Database = types.StringValue(fmt.Sprintf("%v", rec.Values[0]))
+	properties, _ := rec.Values[1].(map[string]any)
+	var diags diag.Diagnostics
+	data.Properties, diags = aliasPropertiesValue(ctx, data.Properties, properties)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the database alias", props)
+}
+
+func (r *DatabaseAliasResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+	var data, state DatabaseAliasResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+databaseAliasSuffix, auditOperationUpdate, &data.Name)
+
+	props := map[string]interface{}{"name": data.Name.ValueString(), "database": data.Database.ValueString()}
+	tflog.Trace(ctx, "update the database alias", props)
+
+	if !data.Database.Equal(state.Database) {
+		if _, err := r.client.RunSystem(ctx, `ALTER ALIAS $name SET DATABASE TARGET $database`, map[string]any{
+			"name":     data.Name.ValueString(),
+			"database": data.Database.ValueString(),
+		}); err != nil {
+			tflog.Debug(ctx, "failed to update the database alias", props)
+			resp.Diagnostics.AddError("failed to update the database alias", err.Error())
+			return
+		}
+	}
+
+	if !data.Properties.Equal(state.Properties) {
+		properties, diags := readAliasProperties(ctx, data.Properties)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		literal, params := aliasProperties(properties)
+		params["name"] = data.Name.ValueString()
+		if _, err := r.client.RunSystem(ctx, `ALTER ALIAS $name SET DATABASE PROPERTIES `+literal,
+			params); err != nil {
+			tflog.Debug(ctx, "failed to update the database alias properties", props)
+			resp.Diagnostics.AddError("failed to update the database alias properties", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "updated the database alias", props)
+}
+
+func (r *DatabaseAliasResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+	var data DatabaseAliasResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+databaseAliasSuffix, auditOperationDelete, &data.Name)
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "drop the database alias", props)
+
+	if _, err := r.client.RunSystem(ctx, `DROP ALIAS $name IF EXISTS FOR DATABASE`,
+		map[string]any{"name": data.Name.ValueString()}); err != nil {
+		tflog.Debug(ctx, "failed to drop the database alias", props)
+		resp.Diagnostics.AddError("failed to drop the database alias", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "dropped the database alias", props)
+}
+
+func (r *DatabaseAliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAliasProperties(t *testing.T) {
+	literal, params := aliasProperties(map[string]string{"team": "data", "odd`key": "x"})
+	assert.Equal(t, "{`odd``key`: $property0, `team`: $property1}", literal)
+	assert.Equal(t, map[string]any{"property0": "x", "property1": "data"}, params)
+
+	literal, params = aliasProperties(nil)
+	assert.Equal(t, "{}", literal)
+	assert.Empty(t, params)
+}
+
+func TestRemoteAliasDriverSettings(t *testing.T) {
+	settings, err := (*DatabaseRemoteAliasDriverModel)(nil).settings()
+	assert.NoError(t, err)
+	assert.Empty(t, settings)
+
+	settings, err = (&DatabaseRemoteAliasDriverModel{
+		SSLEnforced:           types.BoolValue(true),
+		ConnectionTimeout:     types.StringValue("1m30s"),
+		ConnectionPoolMaxSize: types.Int64Value(10),
+	}).settings()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"ssl_enforced":             true,
+		"connection_timeout":       neo4j.DurationOf(0, 0, 90, 0),
+		"connection_pool_max_size": int64(10),
+	}, settings)
+
+	literal, params := mapLiteral("driver", settings)
+	assert.Equal(t, "{`connection_pool_max_size`: $driver0, `connection_timeout`: $driver1, "+
+		"`ssl_enforced`: $driver2}", literal)
+	assert.Len(t, params, 3)
+
+	_, err = (&DatabaseRemoteAliasDriverModel{ConnectionMaxLifetime: types.StringValue("1 hour")}).settings()
+	assert.ErrorContains(t, err, "invalid driver setting connection_max_lifetime")
+}
@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DatabaseDefaultResource{}
+var _ resource.ResourceWithImportState = &DatabaseDefaultResource{}
+
+func NewDatabaseDefaultResource() resource.Resource {
+	return &DatabaseDefaultResource{}
+}
+
+// DatabaseDefaultResource defines the resource to set the default database of the DBMS.
+type DatabaseDefaultResource struct {
+	client *Client
+}
+
+// DatabaseDefaultResourceModel describes the resource data model.
+type DatabaseDefaultResourceModel struct {
+	Database         types.String `tfsdk:"database"`
+	PreviousDatabase types.String `tfsdk:"previous_database"`
+}
+
+const databaseDefaultSuffix = "_database_default"
+
+func (r *DatabaseDefaultResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + databaseDefaultSuffix
+}
+
+func (r *DatabaseDefaultResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sets the default database of the DBMS, e.g. to promote the newly seeded database " +
+			"as part of the cutover. The previous default database is restored on destroy." +
+			"\n\n!>**Warning** The resource requires the Neo4j Enterprise Edition. " +
+			"The database must exist, and the current default database must be stopped, details: " +
+			"https://neo4j.com/docs/operations-manual/current/procedures/#procedure_dbms_setDefaultDatabase",
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				MarkdownDescription: "The name of the database to set as default.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"previous_database": schema.StringAttribute{
+				MarkdownDescription: "The default database before the resource was created, it's restored on destroy. " +
+					"It's not set if the resource is imported, hence the default database is kept on destroy.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *DatabaseDefaultResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// defaultDatabase reads the name of the default database.
+func (r *DatabaseDefaultResource) defaultDatabase(ctx context.Context) (string, error) {
+	dbResp, err := r.client.RunSystem(ctx, `SHOW DEFAULT DATABASE YIELD name RETURN name`, nil)
+	if err != nil {
+		return "", err
+	}
+	return defaultDatabaseName(dbResp.Records)
+}
+
+// defaultDatabaseName maps the records of the default database query to the database name.
+func defaultDatabaseName(records []*neo4j.Record) (string, error) {
+	if len(records) == 0 || len(records[0].Values) == 0 {
+		return "", fmt.Errorf("no default database found")
+	}
+	name, ok := records[0].Values[0].(string)
+	if !ok || name == "" {
+		return "", fmt.Errorf("unexpected default database name: %v", records[0].Values[0])
+	}
+	return name, nil
+}
+
+// restoredDatabase returns the database to restore as default on destroy, it's empty if the previous database
+// is not known, e.g. the resource is imported, or if it's the resource's database.
+func restoredDatabase(data DatabaseDefaultResourceModel) string {
+	if previous := data.PreviousDatabase.ValueString(); previous != data.Database.ValueString() {
+		return previous
+	}
+	return ""
+}
+
+// setDefaultDatabase sets the default database.
+func (r *DatabaseDefaultResource) setDefaultDatabase(ctx context.Context, name string) error {
+	_, err := r.client.RunSystem(ctx, `CALL dbms.setDefaultDatabase($name)`, map[string]any{"name": name})
+	return err
+}
+
+func (r *DatabaseDefaultResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+	var data DatabaseDefaultResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+databaseDefaultSuffix, auditOperationCreate, &data.Database)
+
+	props := map[string]interface{}{"database": data.Database.ValueString()}
+	tflog.Trace(ctx, "set the default database", props)
+
+	previous, err := r.defaultDatabase(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read the default database", err.Error())
+		return
+	}
+	if previous != data.Database.ValueString() {
+		if err := r.setDefaultDatabase(ctx, data.Database.ValueString()); err != nil {
+			tflog.Debug(ctx, "failed to set the default database", props)
+			resp.Diagnostics.AddError("failed to set the default database", err.Error())
+			return
+		}
+	}
+	data.PreviousDatabase = types.StringValue(previous)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "set the default database", props)
+}
+
+func (r *DatabaseDefaultResource) Read(ctx context.Context, req resource.ReadRequest,
+	resp *resource.ReadResponse) {
+	var data DatabaseDefaultResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "reading the default database")
+
+	name, err := r.defaultDatabase(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the default database")
+		resp.Diagnostics.AddError("failed to read the default database", err.Error())
+		return
+	}
+	data.Database = types.StringValue(name)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the default database", map[string]interface{}{"database": name})
+}
+
+func (r *DatabaseDefaultResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+	var data DatabaseDefaultResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+databaseDefaultSuffix, auditOperationUpdate, &data.Database)
+
+	props := map[string]interface{}{"database": data.Database.ValueString()}
+	tflog.Trace(ctx, "update the default database", props)
+
+	if err := r.setDefaultDatabase(ctx, data.Database.ValueString()); err != nil {
+		tflog.Debug(ctx, "failed to set the default database", props)
+		resp.Diagnostics.AddError("failed to set the default database", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "updated the default database", props)
+}
+
+func (r *DatabaseDefaultResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+	var data DatabaseDefaultResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+databaseDefaultSuffix, auditOperationDelete, &data.Database)
+
+	previous := restoredDatabase(data)
+	if previous == "" {
+		return
+	}
+
+	props := map[string]interface{}{"database": previous}
+	tflog.Trace(ctx, "restore the default database", props)
+	if err := r.setDefaultDatabase(ctx, previous); err != nil {
+		tflog.Debug(ctx, "failed to restore the default database", props)
+		resp.Diagnostics.AddError("failed to restore the default database", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "restored the default database", props)
+}
+
+func (r *DatabaseDefaultResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("database"), req, resp)
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultDatabaseName(t *testing.T) {
+	name, err := defaultDatabaseName([]*neo4j.Record{{Keys: []string{"name"}, Values: []any{"movies"}}})
+	assert.NoError(t, err)
+	assert.Equal(t, "movies", name)
+
+	_, err = defaultDatabaseName(nil)
+	assert.ErrorContains(t, err, "no default database found")
+
+	_, err = defaultDatabaseName([]*neo4j.Record{{Keys: []string{"name"}, Values: []any{nil}}})
+	assert.ErrorContains(t, err, "unexpected default database name")
+}
+
+func TestRestoredDatabase(t *testing.T) {
+	tests := map[string]struct {
+		data DatabaseDefaultResourceModel
+		want string
+	}{
+		"previous database": {
+			data: DatabaseDefaultResourceModel{
+				Database:         types.StringValue("movies-v2"),
+				PreviousDatabase: types.StringValue("movies"),
+			},
+			want: "movies",
+		},
+		"same database": {
+			data: DatabaseDefaultResourceModel{
+				Database:         types.StringValue("movies"),
+				PreviousDatabase: types.StringValue("movies"),
+			},
+		},
+		"imported": {
+			data: DatabaseDefaultResourceModel{
+				Database:         types.StringValue("movies"),
+				PreviousDatabase: types.StringNull(),
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, restoredDatabase(tt.data))
+		})
+	}
+}
@@ -0,0 +1,373 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DatabaseGrantResource{}
+var _ resource.ResourceWithImportState = &DatabaseGrantResource{}
+
+func NewDatabaseGrantResource() resource.Resource {
+	return &DatabaseGrantResource{}
+}
+
+// DatabaseGrantResource defines the resource to grant the database privileges to the role.
+type DatabaseGrantResource struct {
+	client *Client
+}
+
+// DatabaseGrantResourceModel describes the resource data model.
+type DatabaseGrantResourceModel struct {
+	Database   types.String `tfsdk:"database"`
+	Role       types.String `tfsdk:"role"`
+	Privileges types.List   `tfsdk:"privileges"`
+}
+
+const (
+	databaseGrantSuffix = "_database_grant"
+
+	databasePrivilegeAccess = "ACCESS"
+	databasePrivilegeStart  = "START"
+	databasePrivilegeStop   = "STOP"
+
+	// allDatabases denotes all databases in the privilege commands.
+	allDatabases = "*"
+)
+
+// privilegeCommandRegexp matches the database privilege command returned by `SHOW PRIVILEGES AS COMMANDS`.
+var privilegeCommandRegexp = regexp.MustCompile("^(GRANT|DENY) (?:IMMUTABLE )?(ACCESS|START|STOP) " +
+	"ON (?:DATABASE|DATABASES) (\\*|`(?:[^`]|``)+`|\\S+) TO (`(?:[^`]|``)+`|\\S+)$")
+
+// privilegeCommand defines the database privilege command.
+type privilegeCommand struct {
+	Verb      string
+	Privilege string
+	Database  string
+	Role      string
+}
+
+// unquoteName reverts quoteName.
+func unquoteName(s string) string {
+	if len(s) > 1 && strings.HasPrefix(s, "`") && strings.HasSuffix(s, "`") {
+		return strings.ReplaceAll(s[1:len(s)-1], "``", "`")
+	}
+	return s
+}
+
+// parsePrivilegeCommand parses the database privilege command, false is returned for other privileges.
+func parsePrivilegeCommand(command string) (privilegeCommand, bool) {
+	m := privilegeCommandRegexp.FindStringSubmatch(command)
+	if m == nil {
+		return privilegeCommand{}, false
+	}
+	return privilegeCommand{Verb: m[1], Privilege: m[2], Database: unquoteName(m[3]), Role: unquoteName(m[4])}, true
+}
+
+// databaseGrantQuery defines the command to grant, or to revoke the privilege.
+func databaseGrantQuery(command, privilege, database string) string {
+	target := "DATABASE $database"
+	if database == allDatabases {
+		target = "DATABASE *"
+	}
+	switch command {
+	case "REVOKE":
+		// Only the granted privilege is revoked, the denied one is kept.
+		return fmt.Sprintf("REVOKE GRANT %s ON %s FROM $role", privilege, target)
+	case "REVOKE DENY":
+		return fmt.Sprintf("REVOKE DENY %s ON %s FROM $role", privilege, target)
+	}
+	return fmt.Sprintf("GRANT %s ON %s TO $role", privilege, target)
+}
+
+func (r *DatabaseGrantResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + databaseGrantSuffix
+}
+
+func (r *DatabaseGrantResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Grants the database privileges to the role, details: " +
+			"https://neo4j.com/docs/operations-manual/current/authentication-authorization/database-administration/" +
+			"\n\nThe privileges granted, or denied outside of Terraform are reported as drift, and reverted on apply." +
+			"\n\n!>**Warning** The resource requires the Neo4j Enterprise Edition.",
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				MarkdownDescription: "The database name, `*` for all databases.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The role to grant the privileges to.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"privileges": schema.ListAttribute{
+				MarkdownDescription: "The granted privileges: `ACCESS`, `START`, or `STOP`.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(
+						stringvalidator.OneOf(databasePrivilegeAccess, databasePrivilegeStart, databasePrivilegeStop),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *DatabaseGrantResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// run grants, or revokes the privileges.
+func (r *DatabaseGrantResource) run(ctx context.Context, command string, privileges []string,
+	data DatabaseGrantResourceModel) error {
+	params := map[string]any{
+		"database": data.Database.ValueString(),
+		"role":     data.Role.ValueString(),
+	}
+	for _, privilege := range privileges {
+		var queries = []string{databaseGrantQuery(command, privilege, data.Database.ValueString())}
+		if command == "GRANT" {
+			// The privilege denied outside of Terraform would take precedence, hence it's reverted.
+			queries = append([]string{databaseGrantQuery("REVOKE DENY", privilege, data.Database.ValueString())},
+				queries...)
+		}
+		for _, query := range queries {
+			if _, err := r.client.RunSystem(ctx, query, params); err != nil {
+				return fmt.Errorf("%s %s: %w", strings.ToLower(command), privilege, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (r *DatabaseGrantResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+	var data DatabaseGrantResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+databaseGrantSuffix, auditOperationCreate, &data.Role)
+
+	var privileges []string
+	resp.Diagnostics.Append(data.Privileges.ElementsAs(ctx, &privileges, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	props := map[string]interface{}{"database": data.Database.ValueString(), "role": data.Role.ValueString()}
+	tflog.Trace(ctx, "grant the database privileges", props)
+
+	if err := r.run(ctx, "GRANT", privileges, data); err != nil {
+		tflog.Debug(ctx, "failed to grant the database privileges", props)
+		resp.Diagnostics.AddError("failed to grant the database privileges", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "granted the database privileges", props)
+}
+
+func (r *DatabaseGrantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DatabaseGrantResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	props := map[string]interface{}{"database": data.Database.ValueString(), "role": data.Role.ValueString()}
+	tflog.Trace(ctx, "reading the database privileges", props)
+
+	// The state is reconstructed from the commands to detect the privileges granted, or denied outside of Terraform.
+	dbResp, err := r.client.RunSystem(ctx, `SHOW ROLE $role PRIVILEGES AS COMMANDS YIELD command RETURN command`,
+		map[string]any{"role": data.Role.ValueString()})
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the database privileges", props)
+		resp.Diagnostics.AddError("failed to read the database privileges", err.Error())
+		return
+	}
+
+	var granted, denied []string
+	for _, rec := range dbResp.Records {
+		command, ok := parsePrivilegeCommand(fmt.Sprintf("%v", rec.Values[0]))
+		if !ok || command.Database != data.Database.ValueString() {
+			continue
+		}
+		switch command.Verb {
+		case "GRANT":
+			granted = append(granted, command.Privilege)
+		case "DENY":
+			denied = append(denied, command.Privilege)
+		}
+	}
+	// The denied privileges are not effective, hence they are planned to be granted again.
+	var privileges = make([]string, 0)
+	for _, privilege := range []string{databasePrivilegeAccess, databasePrivilegeStart, databasePrivilegeStop} {
+		if slices.Contains(granted, privilege) && !slices.Contains(denied, privilege) {
+			privileges = append(privileges, privilege)
+		}
+	}
+	if len(privileges) == 0 {
+		// The privileges were revoked, or denied outside of Terraform, hence they shall be granted.
+		tflog.Debug(ctx, "no database privileges found", props)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var configured []string
+	if !data.Privileges.IsNull() {
+		resp.Diagnostics.Append(data.Privileges.ElementsAs(ctx, &configured, false)...)
+	}
+	if !sameElements(configured, privileges) {
+		var diags diag.Diagnostics
+		data.Privileges, diags = types.ListValueFrom(ctx, types.StringType, privileges)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the database privileges", props)
+}
+
+func (r *DatabaseGrantResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+	var plan, state DatabaseGrantResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+databaseGrantSuffix, auditOperationUpdate, &plan.Role)
+
+	var planned, current []string
+	resp.Diagnostics.Append(plan.Privileges.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(state.Privileges.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var granted, revoked []string
+	for _, privilege := range planned {
+		if !slices.Contains(current, privilege) {
+			granted = append(granted, privilege)
+		}
+	}
+	for _, privilege := range current {
+		if !slices.Contains(planned, privilege) {
+			revoked = append(revoked, privilege)
+		}
+	}
+
+	props := map[string]interface{}{"database": plan.Database.ValueString(), "role": plan.Role.ValueString()}
+	tflog.Trace(ctx, "update the database privileges", props)
+
+	if err := r.run(ctx, "GRANT", granted, plan); err != nil {
+		tflog.Debug(ctx, "failed to grant the database privileges", props)
+		resp.Diagnostics.AddError("failed to grant the database privileges", err.Error())
+		return
+	}
+	if err := r.run(ctx, "REVOKE", revoked, plan); err != nil {
+		tflog.Debug(ctx, "failed to revoke the database privileges", props)
+		resp.Diagnostics.AddError("failed to revoke the database privileges", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "updated the database privileges", props)
+}
+
+func (r *DatabaseGrantResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+	var data DatabaseGrantResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+databaseGrantSuffix, auditOperationDelete, &data.Role)
+
+	var privileges []string
+	resp.Diagnostics.Append(data.Privileges.ElementsAs(ctx, &privileges, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	props := map[string]interface{}{"database": data.Database.ValueString(), "role": data.Role.ValueString()}
+	tflog.Trace(ctx, "revoke the database privileges", props)
+	if err := r.run(ctx, "REVOKE", privileges, data); err != nil {
+		tflog.Debug(ctx, "failed to revoke the database privileges", props)
+		resp.Diagnostics.AddError("failed to revoke the database privileges", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "revoked the database privileges", props)
+}
+
+func (r *DatabaseGrantResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+	database, role, ok := strings.Cut(req.ID, "/")
+	if !ok || database == "" || role == "" {
+		resp.Diagnostics.AddError("unexpected import identifier",
+			fmt.Sprintf("expected the identifier in the format <database>/<role>, got: %s", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), database)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), role)...)
+}
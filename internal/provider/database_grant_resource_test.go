@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabaseGrantQuery(t *testing.T) {
+	assert.Equal(t, "GRANT ACCESS ON DATABASE $database TO $role",
+		databaseGrantQuery("GRANT", databasePrivilegeAccess, "movies"))
+	assert.Equal(t, "GRANT START ON DATABASE * TO $role",
+		databaseGrantQuery("GRANT", databasePrivilegeStart, allDatabases))
+	assert.Equal(t, "REVOKE GRANT STOP ON DATABASE $database FROM $role",
+		databaseGrantQuery("REVOKE", databasePrivilegeStop, "movies"))
+	assert.Equal(t, "REVOKE DENY ACCESS ON DATABASE $database FROM $role",
+		databaseGrantQuery("REVOKE DENY", databasePrivilegeAccess, "movies"))
+}
+
+func TestParsePrivilegeCommand(t *testing.T) {
+	tests := []struct {
+		command string
+		want    privilegeCommand
+		ok      bool
+	}{
+		{
+			command: "GRANT ACCESS ON DATABASE `movies` TO `reader`",
+			want:    privilegeCommand{Verb: "GRANT", Privilege: "ACCESS", Database: "movies", Role: "reader"},
+			ok:      true,
+		},
+		{
+			command: "DENY START ON DATABASE * TO `ops``team`",
+			want:    privilegeCommand{Verb: "DENY", Privilege: "START", Database: "*", Role: "ops`team"},
+			ok:      true,
+		},
+		{
+			command: "GRANT IMMUTABLE STOP ON DATABASE `my db` TO operator",
+			want:    privilegeCommand{Verb: "GRANT", Privilege: "STOP", Database: "my db", Role: "operator"},
+			ok:      true,
+		},
+		{
+			command: "GRANT MATCH {*} ON GRAPH `movies` NODE * TO `reader`",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.command, func(t *testing.T) {
+			got, ok := parsePrivilegeCommand(tt.command)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DatabaseReadyDataSource{}
+
+func NewDatabaseReadyDataSource() datasource.DataSource {
+	return &DatabaseReadyDataSource{}
+}
+
+// DatabaseReadyDataSource defines the data source to wait until the database is online.
+type DatabaseReadyDataSource struct {
+	client *Client
+}
+
+// DatabaseReadyDataSourceModel describes the data source data model.
+type DatabaseReadyDataSourceModel struct {
+	Name      types.String `tfsdk:"name"`
+	Timeout   types.String `tfsdk:"timeout"`
+	Addresses types.List   `tfsdk:"addresses"`
+}
+
+const (
+	databaseReadySuffix = "_database_ready"
+
+	databaseReadyDefaultTimeout = 5 * time.Minute
+	databaseReadyPollInterval   = 2 * time.Second
+)
+
+func (d *DatabaseReadyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + databaseReadySuffix
+}
+
+func (d *DatabaseReadyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Waits until the database is online on all servers hosting it, " +
+			"e.g. to seed the database after it's created.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The database name.",
+				Required:            true,
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "The maximum time to wait, e.g. `30s`, defaults to `5m`.",
+				Optional:            true,
+			},
+			"addresses": schema.ListAttribute{
+				MarkdownDescription: "The addresses of the servers hosting the database.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *DatabaseReadyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// databaseStatus reads the addresses of the servers hosting the database, and of the servers it's not online on.
+func (c *Client) databaseStatus(ctx context.Context, name string) (addresses, pending []string, err error) {
+	dbResp, err := c.RunSystem(ctx, `SHOW DATABASE $name YIELD address, currentStatus
+RETURN address, currentStatus ORDER BY address`, map[string]any{"name": name})
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, rec := range dbResp.Records {
+		address := fmt.Sprintf("%v", rec.Values[0])
+		addresses = append(addresses, address)
+		if rec.Values[1] != "online" {
+			pending = append(pending, fmt.Sprintf("%s: %v", address, rec.Values[1]))
+		}
+	}
+	return addresses, pending, nil
+}
+
+// waitDatabaseOnline polls the database status until it's online on all servers hosting it,
+// and returns the servers' addresses. It fails if the database is not online within the timeout.
+func (c *Client) waitDatabaseOnline(ctx context.Context, name string, timeout time.Duration) (addresses []string,
+	diags diag.Diagnostics) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var pending []string
+	for {
+		var err error
+		addresses, pending, err = c.databaseStatus(ctx, name)
+		switch {
+		case err != nil && ctx.Err() == nil:
+			diags.AddError("failed to read the database status", err.Error())
+			return nil, diags
+		case err == nil && len(addresses) > 0 && len(pending) == 0:
+			return addresses, diags
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.Canceled) {
+				diags.AddError("cancelled waiting for the database to be online", ctx.Err().Error())
+				return nil, diags
+			}
+			detail := "the database is not found"
+			if len(addresses) > 0 {
+				detail = "the database is not online on " + strings.Join(pending, ", ")
+			}
+			diags.AddError("timed out waiting for the database to be online",
+				fmt.Sprintf("%s, waited for %s", detail, timeout))
+			return nil, diags
+		case <-time.After(databaseReadyPollInterval):
+		}
+	}
+}
+
+func (d *DatabaseReadyDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+	var data DatabaseReadyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := databaseReadyDefaultTimeout
+	if !data.Timeout.IsNull() {
+		var err error
+		if timeout, err = time.ParseDuration(data.Timeout.ValueString()); err != nil || timeout <= 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("timeout"), "invalid timeout",
+				fmt.Sprintf("expected the positive duration, e.g. 30s, got: %s", data.Timeout.ValueString()))
+			return
+		}
+	}
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "waiting for the database to be online", props)
+
+	addresses, diags := d.client.waitDatabaseOnline(ctx, data.Name.ValueString(), timeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		tflog.Debug(ctx, "failed to wait for the database to be online", props)
+		return
+	}
+
+	data.Addresses, diags = types.ListValueFrom(ctx, types.StringType, addresses)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "the database is online", props)
+}
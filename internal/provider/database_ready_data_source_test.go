@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccDatabaseReadyDataSource(t *testing.T) {
+	t.Setenv("DB_URI", testDbURI)
+	t.Setenv("DB_USER", testDBUser)
+	t.Cleanup(func() {
+		t.Setenv("DB_URI", "")
+		t.Setenv("DB_USER", "")
+	})
+
+	const dataSourceAddress = "data." + Name + databaseReadySuffix + "._"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "neo4j_database_ready" "_" {
+name = "neo4j"
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("addresses"),
+						knownvalue.ListSizeExact(1)),
+				},
+			},
+			{
+				Config: `data "neo4j_database_ready" "_" {
+name    = "missing"
+timeout = "3s"
+}`,
+				ExpectError: regexp.MustCompile(`timed out waiting for the database to be online`),
+			},
+			{
+				Config: `data "neo4j_database_ready" "_" {
+name    = "neo4j"
+timeout = "soon"
+}`,
+				ExpectError: regexp.MustCompile(`invalid timeout`),
+			},
+		},
+	})
+}
@@ -0,0 +1,422 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DatabaseRemoteAliasResource{}
+var _ resource.ResourceWithImportState = &DatabaseRemoteAliasResource{}
+
+func NewDatabaseRemoteAliasResource() resource.Resource {
+	return &DatabaseRemoteAliasResource{}
+}
+
+// DatabaseRemoteAliasResource defines the resource to manage the alias of the database on the remote DBMS.
+type DatabaseRemoteAliasResource struct {
+	client *Client
+}
+
+// DatabaseRemoteAliasResourceModel describes the resource data model.
+type DatabaseRemoteAliasResourceModel struct {
+	Name            types.String                    `tfsdk:"name"`
+	Database        types.String                    `tfsdk:"database"`
+	URL             types.String                    `tfsdk:"url"`
+	User            types.String                    `tfsdk:"user"`
+	Password        types.String                    `tfsdk:"password"`
+	PasswordVersion types.Int64                     `tfsdk:"password_version"`
+	Properties      types.Map                       `tfsdk:"properties"`
+	Driver          *DatabaseRemoteAliasDriverModel `tfsdk:"driver"`
+}
+
+// DatabaseRemoteAliasDriverModel describes the settings of the driver connecting to the remote DBMS.
+type DatabaseRemoteAliasDriverModel struct {
+	SSLEnforced                      types.Bool   `tfsdk:"ssl_enforced"`
+	ConnectionTimeout                types.String `tfsdk:"connection_timeout"`
+	ConnectionMaxLifetime            types.String `tfsdk:"connection_max_lifetime"`
+	ConnectionPoolAcquisitionTimeout types.String `tfsdk:"connection_pool_acquisition_timeout"`
+	ConnectionPoolIdleTest           types.String `tfsdk:"connection_pool_idle_test"`
+	ConnectionPoolMaxSize            types.Int64  `tfsdk:"connection_pool_max_size"`
+	LoggingLevel                     types.String `tfsdk:"logging_level"`
+}
+
+const databaseRemoteAliasSuffix = "_database_remote_alias"
+
+// settings defines the driver settings of the alias, the durations are parsed from the configuration.
+func (d *DatabaseRemoteAliasDriverModel) settings() (map[string]any, error) {
+	var o = map[string]any{}
+	if d == nil {
+		return o, nil
+	}
+
+	if !d.SSLEnforced.IsNull() {
+		o["ssl_enforced"] = d.SSLEnforced.ValueBool()
+	}
+	if !d.ConnectionPoolMaxSize.IsNull() {
+		o["connection_pool_max_size"] = d.ConnectionPoolMaxSize.ValueInt64()
+	}
+	if !d.LoggingLevel.IsNull() {
+		o["logging_level"] = d.LoggingLevel.ValueString()
+	}
+
+	var errs []error
+	for _, v := range []struct {
+		name  string
+		value types.String
+	}{
+		{"connection_timeout", d.ConnectionTimeout},
+		{"connection_max_lifetime", d.ConnectionMaxLifetime},
+		{"connection_pool_acquisition_timeout", d.ConnectionPoolAcquisitionTimeout},
+		{"connection_pool_idle_test", d.ConnectionPoolIdleTest},
+	} {
+		if v.value.IsNull() {
+			continue
+		}
+		duration, err := time.ParseDuration(v.value.ValueString())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid driver setting %s: %w", v.name, err))
+			continue
+		}
+		o[v.name] = neo4j.DurationOf(0, 0, int64(duration/time.Second), int(duration%time.Second))
+	}
+	return o, errors.Join(errs...)
+}
+
+// remoteAliasTarget defines the clauses of the remote alias target, and their parameters.
+func remoteAliasTarget(ctx context.Context, data DatabaseRemoteAliasResourceModel) (string, map[string]any,
+	diag.Diagnostics) {
+	var diags diag.Diagnostics
+	properties, d := readAliasProperties(ctx, data.Properties)
+	diags.Append(d...)
+	settings, err := data.Driver.settings()
+	if err != nil {
+		diags.AddError("failed to read the driver settings", err.Error())
+	}
+	if diags.HasError() {
+		return "", nil, diags
+	}
+
+	propertiesLiteral, params := aliasProperties(properties)
+	driverLiteral, driverParams := mapLiteral("driver", settings)
+	maps.Copy(params, driverParams)
+	params["name"] = data.Name.ValueString()
+	params["database"] = data.Database.ValueString()
+	params["url"] = data.URL.ValueString()
+	params["user"] = data.User.ValueString()
+	params["password"] = data.Password.ValueString()
+	return `AT $url USER $user PASSWORD $password DRIVER ` + driverLiteral + ` PROPERTIES ` + propertiesLiteral,
+		params, diags
+}
+
+// sameTarget checks if the alias targets the same database with the same user and settings,
+// i.e. only the password is changed, or rotated.
+func (m DatabaseRemoteAliasResourceModel) sameTarget(o DatabaseRemoteAliasResourceModel) bool {
+	return m.Database.Equal(o.Database) && m.URL.Equal(o.URL) && m.User.Equal(o.User) &&
+		m.Properties.Equal(o.Properties) && reflect.DeepEqual(m.Driver, o.Driver)
+}
+
+func (r *DatabaseRemoteAliasResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + databaseRemoteAliasSuffix
+}
+
+func (r *DatabaseRemoteAliasResource) Schema(_ context.Context, _ resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+	durationAttribute := func(description string) schema.Attribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			Validators:          durationValidators,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the alias of the database on the remote DBMS, details: " +
+			"https://neo4j.com/docs/operations-manual/current/database-administration/aliases/manage-remote-database-aliases/" +
+			"\n\n!>**Warning** The resource requires the Neo4j Enterprise Edition. " +
+			"The password is stored in the Terraform state, hence the state shall be stored securely.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the alias. The alias is re-created if it's renamed.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"database": schema.StringAttribute{
+				MarkdownDescription: "The name of the target database on the remote DBMS.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the remote DBMS, e.g. `neo4j+s://remote.example.com:7687`.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "The user to connect to the remote DBMS.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of the user to connect to the remote DBMS. " +
+					"It can't be read from the database, hence it's not checked for drift, " +
+					"and it's set on the next apply after the alias is imported.",
+				Required:   true,
+				Sensitive:  true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"password_version": schema.Int64Attribute{
+				MarkdownDescription: "The version of the password, change it to rotate the credentials, " +
+					"i.e. to set the `password` again, e.g. after the password is changed on the remote DBMS " +
+					"outside of Terraform. Only the password is altered if the target and the settings don't change.",
+				Optional: true,
+			},
+			"properties": schema.MapAttribute{
+				MarkdownDescription: "The properties of the alias, e.g. to describe its purpose.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"driver": schema.SingleNestedBlock{
+				MarkdownDescription: "The settings of the driver connecting to the remote DBMS, " +
+					"the DBMS's defaults are used if not set. The settings are not checked for drift.",
+				Attributes: map[string]schema.Attribute{
+					"ssl_enforced": schema.BoolAttribute{
+						MarkdownDescription: "Set to enforce the TLS connection to the remote DBMS.",
+						Optional:            true,
+					},
+					"connection_timeout": durationAttribute("The maximum time to establish the connection, " +
+						"e.g. `5s`."),
+					"connection_max_lifetime": durationAttribute("The maximum time the connection is kept " +
+						"in the pool, e.g. `1h`."),
+					"connection_pool_acquisition_timeout": durationAttribute("The maximum time to wait for " +
+						"a connection from the pool, e.g. `1m`."),
+					"connection_pool_idle_test": durationAttribute("The idle time after which the connection " +
+						"is checked before it's used, e.g. `5m`."),
+					"connection_pool_max_size": schema.Int64Attribute{
+						MarkdownDescription: "The maximum number of the connections to the remote DBMS.",
+						Optional:            true,
+						Validators:          []validator.Int64{int64validator.AtLeast(1)},
+					},
+					"logging_level": schema.StringAttribute{
+						MarkdownDescription: "The level of the driver's logs, e.g. `INFO`.",
+						Optional:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DatabaseRemoteAliasResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DatabaseRemoteAliasResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+	var data DatabaseRemoteAliasResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+databaseRemoteAliasSuffix, auditOperationCreate, &data.Name)
+
+	ctx = r.client.maskSensitive(ctx, data.Password.ValueString())
+	target, params, diags := remoteAliasTarget(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	props := map[string]interface{}{
+		"name":     data.Name.ValueString(),
+		"database": data.Database.ValueString(),
+		"url":      data.URL.ValueString(),
+	}
+	tflog.Trace(ctx, "create the remote database alias", props)
+
+	if _, err := r.client.RunSystem(ctx, `CREATE ALIAS $name FOR DATABASE $database `+target,
+		params); err != nil {
+		tflog.Debug(ctx, "failed to create the remote database alias", props)
+		resp.Diagnostics.AddError("failed to create the remote database alias", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "created the remote database alias", props)
+}
+
+func (r *DatabaseRemoteAliasResource) Read(ctx context.Context, req resource.ReadRequest,
+	resp *resource.ReadResponse) {
+	var data DatabaseRemoteAliasResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "reading the remote database alias", props)
+
+	dbResp, err := r.client.RunSystem(ctx, `SHOW ALIASES FOR DATABASE
+YIELD name, database, location, url, user, properties
+WHERE name = $name AND location = "remote"
+RETURN database, url, user, properties`, map[string]any{"name": data.Name.ValueString()})
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the remote database alias", props)
+		resp.Diagnostics.AddError("failed to read the remote database alias", err.Error())
+		return
+	}
+	if len(dbResp.Records) == 0 {
+		tflog.Debug(ctx, "no remote database alias found", props)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	rec := dbResp.Records[0]
+	data.Database = types.StringValue(fmt.Sprintf("%v", rec.Values[0]))
+	data.URL = types.StringValue(fmt.Sprintf("%v", rec.Values[1]))
+	data.User = types.StringValue(fmt.Sprintf("%v", rec.Values[2]))
+	properties, _ := rec.Values[3].(map[string]any)
+	var diags diag.Diagnostics
+	data.Properties, diags = aliasPropertiesValue(ctx, data.Properties, properties)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the remote database alias", props)
+}
+
+func (r *DatabaseRemoteAliasResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+	var data, state DatabaseRemoteAliasResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+databaseRemoteAliasSuffix, auditOperationUpdate, &data.Name)
+
+	ctx = r.client.maskSensitive(ctx, data.Password.ValueString())
+	props := map[string]interface{}{
+		"name":     data.Name.ValueString(),
+		"database": data.Database.ValueString(),
+		"url":      data.URL.ValueString(),
+	}
+
+	if data.sameTarget(state) {
+		tflog.Trace(ctx, "rotate the remote database alias password", props)
+		if _, err := r.client.RunSystem(ctx, `ALTER ALIAS $name SET DATABASE PASSWORD $password`,
+			map[string]any{"name": data.Name.ValueString(), "password": data.Password.ValueString()}); err != nil {
+			tflog.Debug(ctx, "failed to rotate the remote database alias password", props)
+			resp.Diagnostics.AddError("failed to rotate the remote database alias password", err.Error())
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		tflog.Trace(ctx, "rotated the remote database alias password", props)
+		return
+	}
+
+	target, params, diags := remoteAliasTarget(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "update the remote database alias", props)
+
+	// the target, the credentials and the settings are set at once, since they're altered together.
+	if _, err := r.client.RunSystem(ctx, `ALTER ALIAS $name SET DATABASE TARGET $database `+target,
+		params); err != nil {
+		tflog.Debug(ctx, "failed to update the remote database alias", props)
+		resp.Diagnostics.AddError("failed to update the remote database alias", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "updated the remote database alias", props)
+}
+
+func (r *DatabaseRemoteAliasResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+	var data DatabaseRemoteAliasResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+databaseRemoteAliasSuffix, auditOperationDelete, &data.Name)
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "drop the remote database alias", props)
+
+	if _, err := r.client.RunSystem(ctx, `DROP ALIAS $name IF EXISTS FOR DATABASE`,
+		map[string]any{"name": data.Name.ValueString()}); err != nil {
+		tflog.Debug(ctx, "failed to drop the remote database alias", props)
+		resp.Diagnostics.AddError("failed to drop the remote database alias", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "dropped the remote database alias", props)
+}
+
+func (r *DatabaseRemoteAliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
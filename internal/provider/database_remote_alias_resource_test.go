@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabaseRemoteAliasSameTarget(t *testing.T) {
+	state := DatabaseRemoteAliasResourceModel{
+		Name:            types.StringValue("remote-orders"),
+		Database:        types.StringValue("orders"),
+		URL:             types.StringValue("neo4j+s://remote.example.com:7687"),
+		User:            types.StringValue("alias_user"),
+		Password:        types.StringValue("secret"),
+		PasswordVersion: types.Int64Value(1),
+		Properties:      types.MapNull(types.StringType),
+		Driver:          &DatabaseRemoteAliasDriverModel{ConnectionTimeout: types.StringValue("5s")},
+	}
+
+	// the password is rotated
+	rotated := state
+	rotated.Password = types.StringValue("rotated")
+	rotated.PasswordVersion = types.Int64Value(2)
+	rotated.Driver = &DatabaseRemoteAliasDriverModel{ConnectionTimeout: types.StringValue("5s")}
+	assert.True(t, rotated.sameTarget(state))
+
+	retargeted := rotated
+	retargeted.URL = types.StringValue("neo4j+s://other.example.com:7687")
+	assert.False(t, retargeted.sameTarget(state))
+
+	reconfigured := rotated
+	reconfigured.Driver = &DatabaseRemoteAliasDriverModel{ConnectionTimeout: types.StringValue("10s")}
+	assert.False(t, reconfigured.sameTarget(state))
+}
@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DatabaseResource{}
+var _ resource.ResourceWithImportState = &DatabaseResource{}
+
+func NewDatabaseResource() resource.Resource {
+	return &DatabaseResource{}
+}
+
+// DatabaseResource defines the resource to manage the standard database.
+type DatabaseResource struct {
+	client *Client
+}
+
+// DatabaseResourceModel describes the resource data model.
+type DatabaseResourceModel struct {
+	Name            types.String `tfsdk:"name"`
+	SeedURI         types.String `tfsdk:"seed_uri"`
+	SeedCredentials types.String `tfsdk:"seed_credentials"`
+	SeedConfig      types.String `tfsdk:"seed_config"`
+	Access          types.String `tfsdk:"access"`
+	WaitTimeout     types.String `tfsdk:"wait_timeout"`
+}
+
+const (
+	databaseSuffix = "_database"
+
+	databaseAccessReadWrite = "read-write"
+	databaseAccessReadOnly  = "read-only"
+)
+
+// alterAccessQuery defines the command to set the database access mode.
+func alterAccessQuery(access string) string {
+	if access == databaseAccessReadOnly {
+		return "ALTER DATABASE $name SET ACCESS READ ONLY"
+	}
+	return "ALTER DATABASE $name SET ACCESS READ WRITE"
+}
+
+// createDatabaseQuery defines the command to create the database, and its parameters.
+// The database is seeded from the backup, or the dump if the seed URI is set.
+func createDatabaseQuery(data DatabaseResourceModel) (string, map[string]any) {
+	var params = map[string]any{"name": data.Name.ValueString()}
+	if data.SeedURI.IsNull() {
+		return "CREATE DATABASE $name", params
+	}
+
+	var options = []string{"existingData: 'use'", "seedURI: $seedURI"}
+	params["seedURI"] = data.SeedURI.ValueString()
+	if !data.SeedCredentials.IsNull() {
+		options = append(options, "seedCredentials: $seedCredentials")
+		params["seedCredentials"] = data.SeedCredentials.ValueString()
+	}
+	if !data.SeedConfig.IsNull() {
+		options = append(options, "seedConfig: $seedConfig")
+		params["seedConfig"] = data.SeedConfig.ValueString()
+	}
+	return "CREATE DATABASE $name OPTIONS {" + strings.Join(options, ", ") + "}", params
+}
+
+func (r *DatabaseResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + databaseSuffix
+}
+
+func (r *DatabaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the standard database, details: " +
+			"https://neo4j.com/docs/operations-manual/current/database-administration/standard-databases/create-databases/" +
+			"\n\nThe database can be seeded from the backup, or the dump, e.g. stored in S3, or GCS. " +
+			"The seed is only read when the database is created." +
+			"\n\n!>**Warning** The database and all its data are dropped on destroy. " +
+			"The resource requires the Neo4j Enterprise Edition.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the database.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"seed_uri": schema.StringAttribute{
+				MarkdownDescription: "The URI of the backup, or the dump to seed the database from, " +
+					"e.g. `s3://bucket/orders.backup`. The database is re-created if it changes.",
+				Optional:      true,
+				Validators:    []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"seed_credentials": schema.StringAttribute{
+				MarkdownDescription: "The credentials to read the seed, e.g. `accessKey;secretKey` for S3. " +
+					"They're only used when the database is created, hence the database is kept if they change, " +
+					"e.g. when they're rotated.",
+				Optional:   true,
+				Sensitive:  true,
+				Validators: []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("seed_uri"))},
+			},
+			"seed_config": schema.StringAttribute{
+				MarkdownDescription: "The configuration of the seed provider, e.g. `region=eu-west-1`. " +
+					"The database is re-created if it changes.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("seed_uri")),
+				},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"access": schema.StringAttribute{
+				MarkdownDescription: "The access mode of the database: `read-write`, or `read-only`, " +
+					"e.g. to freeze the database during the migration. Defaults to `read-write`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(databaseAccessReadWrite),
+				Validators: []validator.String{
+					stringvalidator.OneOf(databaseAccessReadWrite, databaseAccessReadOnly),
+				},
+			},
+			"wait_timeout": schema.StringAttribute{
+				MarkdownDescription: "The maximum time to wait until the created database is online " +
+					"on all servers hosting it, e.g. `10m` for the large seed. " +
+					"The resources depending on the database are only applied once it's online. Defaults to `5m`.",
+				Optional:   true,
+				Validators: durationValidators,
+			},
+		},
+	}
+}
+
+func (r *DatabaseResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// setAccess sets the database access mode.
+func (r *DatabaseResource) setAccess(ctx context.Context, data DatabaseResourceModel) error {
+	_, err := r.client.RunSystem(ctx, alterAccessQuery(data.Access.ValueString()),
+		map[string]any{"name": data.Name.ValueString()})
+	return err
+}
+
+func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DatabaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+databaseSuffix, auditOperationCreate, &data.Name)
+
+	ctx = r.client.maskSensitive(ctx, data.SeedCredentials.ValueString())
+	props := map[string]interface{}{"name": data.Name.ValueString(), "seed_uri": data.SeedURI.ValueString()}
+	tflog.Trace(ctx, "create the database", props)
+
+	timeout := databaseReadyDefaultTimeout
+	if !data.WaitTimeout.IsNull() {
+		var err error
+		if timeout, err = time.ParseDuration(data.WaitTimeout.ValueString()); err != nil || timeout <= 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("wait_timeout"), "invalid timeout",
+				fmt.Sprintf("expected the positive duration, e.g. 30s, got: %s", data.WaitTimeout.ValueString()))
+			return
+		}
+	}
+
+	query, params := createDatabaseQuery(data)
+	if _, err := r.client.RunSystem(ctx, query, params); err != nil {
+		tflog.Debug(ctx, "failed to create the database", props)
+		resp.Diagnostics.AddError("failed to create the database", err.Error())
+		return
+	}
+
+	// The database is started asynchronously, e.g. while the seed is restored.
+	// The state is set even if it doesn't get online, hence the database is tainted instead of being left behind.
+	tflog.Trace(ctx, "waiting for the database to be online", props)
+	if _, diags := r.client.waitDatabaseOnline(ctx, data.Name.ValueString(), timeout); diags.HasError() {
+		tflog.Debug(ctx, "failed to wait for the database to be online", props)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// The database is created with the read-write access.
+	// The state is set if the access fails to be changed, hence the database is tainted instead of being left behind.
+	if data.Access.ValueString() != databaseAccessReadWrite {
+		if err := r.setAccess(ctx, data); err != nil {
+			tflog.Debug(ctx, "failed to set the database access", props)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.AddError("failed to set the database access", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "created the database", props)
+}
+
+func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DatabaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "reading the database", props)
+
+	dbResp, err := r.client.RunSystem(ctx, `SHOW DATABASE $name YIELD name, access RETURN access LIMIT 1`,
+		map[string]any{"name": data.Name.ValueString()})
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the database", props)
+		resp.Diagnostics.AddError("failed to read the database", err.Error())
+		return
+	}
+	if len(dbResp.Records) == 0 {
+		tflog.Debug(ctx, "no database found", props)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if v, ok := dbResp.Records[0].Values[0].(string); ok {
+		data.Access = types.StringValue(v)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the database", props)
+}
+
+// Update sets the access mode, the seed credentials are only kept in the state,
+// since they're not used after the database is created.
+func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DatabaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Access.Equal(state.Access) {
+		resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		defer r.client.audit(ctx, &resp.Diagnostics, Name+databaseSuffix, auditOperationUpdate, &data.Name)
+
+		props := map[string]interface{}{"name": data.Name.ValueString(), "access": data.Access.ValueString()}
+		tflog.Trace(ctx, "update the database access", props)
+		if err := r.setAccess(ctx, data); err != nil {
+			tflog.Debug(ctx, "failed to update the database access", props)
+			resp.Diagnostics.AddError("failed to update the database access", err.Error())
+			return
+		}
+		tflog.Trace(ctx, "updated the database access", props)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DatabaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+databaseSuffix, auditOperationDelete, &data.Name)
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "drop the database", props)
+
+	if _, err := r.client.RunSystem(ctx, `DROP DATABASE $name IF EXISTS`,
+		map[string]any{"name": data.Name.ValueString()}); err != nil {
+		tflog.Debug(ctx, "failed to drop the database", props)
+		resp.Diagnostics.AddError("failed to drop the database", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "dropped the database", props)
+}
+
+func (r *DatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateDatabaseQuery(t *testing.T) {
+	query, params := createDatabaseQuery(DatabaseResourceModel{
+		Name:            types.StringValue("orders"),
+		SeedURI:         types.StringNull(),
+		SeedCredentials: types.StringNull(),
+		SeedConfig:      types.StringNull(),
+	})
+	assert.Equal(t, "CREATE DATABASE $name", query)
+	assert.Equal(t, map[string]any{"name": "orders"}, params)
+
+	query, params = createDatabaseQuery(DatabaseResourceModel{
+		Name:            types.StringValue("orders"),
+		SeedURI:         types.StringValue("s3://bucket/orders.backup"),
+		SeedCredentials: types.StringValue("key;secret"),
+		SeedConfig:      types.StringNull(),
+	})
+	assert.Equal(t, "CREATE DATABASE $name OPTIONS {existingData: 'use', seedURI: $seedURI, "+
+		"seedCredentials: $seedCredentials}", query)
+	assert.Equal(t, map[string]any{
+		"name":            "orders",
+		"seedURI":         "s3://bucket/orders.backup",
+		"seedCredentials": "key;secret",
+	}, params)
+}
+
+func TestAlterAccessQuery(t *testing.T) {
+	assert.Equal(t, "ALTER DATABASE $name SET ACCESS READ ONLY", alterAccessQuery(databaseAccessReadOnly))
+	assert.Equal(t, "ALTER DATABASE $name SET ACCESS READ WRITE", alterAccessQuery(databaseAccessReadWrite))
+}
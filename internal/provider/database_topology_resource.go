@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DatabaseTopologyResource{}
+var _ resource.ResourceWithImportState = &DatabaseTopologyResource{}
+
+func NewDatabaseTopologyResource() resource.Resource {
+	return &DatabaseTopologyResource{}
+}
+
+// DatabaseTopologyResource defines the resource to manage the topology of the database in the cluster.
+type DatabaseTopologyResource struct {
+	client *Client
+}
+
+// DatabaseTopologyResourceModel describes the resource data model.
+type DatabaseTopologyResourceModel struct {
+	Database    types.String `tfsdk:"database"`
+	Primaries   types.Int64  `tfsdk:"primaries"`
+	Secondaries types.Int64  `tfsdk:"secondaries"`
+}
+
+const databaseTopologySuffix = "_database_topology"
+
+// alterTopologyQuery defines the command to set the database topology.
+// The number of the allocations cannot be passed as parameters, hence they're set in the query.
+func alterTopologyQuery(primaries, secondaries int64) string {
+	return fmt.Sprintf("ALTER DATABASE $name SET TOPOLOGY %d PRIMARIES %d SECONDARIES", primaries, secondaries)
+}
+
+func (r *DatabaseTopologyResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + databaseTopologySuffix
+}
+
+func (r *DatabaseTopologyResource) Schema(_ context.Context, _ resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the number of the primary and secondary allocations of the database " +
+			"in the cluster, details: " +
+			"https://neo4j.com/docs/operations-manual/current/clustering/databases/#alter-topology" +
+			"\n\nThe database is neither created, nor dropped by the resource, its topology is kept on destroy." +
+			"\n\n!>**Warning** The resource requires the Neo4j Enterprise Edition.",
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				MarkdownDescription: "The name of the database.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"primaries": schema.Int64Attribute{
+				MarkdownDescription: "The number of the primary allocations, i.e. the servers hosting the database " +
+					"in the primary mode to accept the writes.",
+				Required:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"secondaries": schema.Int64Attribute{
+				MarkdownDescription: "The number of the secondary allocations, i.e. the servers hosting " +
+					"the read-only copies of the database. Defaults to 0.",
+				Optional:   true,
+				Computed:   true,
+				Default:    int64default.StaticInt64(0),
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
+		},
+	}
+}
+
+func (r *DatabaseTopologyResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// alter sets the database topology.
+func (r *DatabaseTopologyResource) alter(ctx context.Context, data DatabaseTopologyResourceModel) error {
+	_, err := r.client.RunSystem(ctx, alterTopologyQuery(data.Primaries.ValueInt64(), data.Secondaries.ValueInt64()),
+		map[string]any{"name": data.Database.ValueString()})
+	return err
+}
+
+func (r *DatabaseTopologyResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+	var data DatabaseTopologyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+databaseTopologySuffix, auditOperationCreate, &data.Database)
+
+	props := map[string]interface{}{
+		"database":    data.Database.ValueString(),
+		"primaries":   data.Primaries.ValueInt64(),
+		"secondaries": data.Secondaries.ValueInt64(),
+	}
+	tflog.Trace(ctx, "set the database topology", props)
+
+	if err := r.alter(ctx, data); err != nil {
+		tflog.Debug(ctx, "failed to set the database topology", props)
+		resp.Diagnostics.AddError("failed to set the database topology", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "set the database topology", props)
+}
+
+func (r *DatabaseTopologyResource) Read(ctx context.Context, req resource.ReadRequest,
+	resp *resource.ReadResponse) {
+	var data DatabaseTopologyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	props := map[string]interface{}{"database": data.Database.ValueString()}
+	tflog.Trace(ctx, "reading the database topology", props)
+
+	// The database is listed once per the server hosting it, the requested topology is the same for all of them.
+	dbResp, err := r.client.RunSystem(ctx, `SHOW DATABASE $name
+YIELD name, requestedPrimariesCount, requestedSecondariesCount
+RETURN requestedPrimariesCount, requestedSecondariesCount LIMIT 1`, map[string]any{"name": data.Database.ValueString()})
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the database topology", props)
+		resp.Diagnostics.AddError("failed to read the database topology", err.Error())
+		return
+	}
+	if len(dbResp.Records) == 0 {
+		tflog.Debug(ctx, "no database found", props)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	rec := dbResp.Records[0]
+	if v, ok := rec.Values[0].(int64); ok {
+		data.Primaries = types.Int64Value(v)
+	}
+	if v, ok := rec.Values[1].(int64); ok {
+		data.Secondaries = types.Int64Value(v)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the database topology", props)
+}
+
+func (r *DatabaseTopologyResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+	var data DatabaseTopologyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+databaseTopologySuffix, auditOperationUpdate, &data.Database)
+
+	props := map[string]interface{}{
+		"database":    data.Database.ValueString(),
+		"primaries":   data.Primaries.ValueInt64(),
+		"secondaries": data.Secondaries.ValueInt64(),
+	}
+	tflog.Trace(ctx, "update the database topology", props)
+
+	if err := r.alter(ctx, data); err != nil {
+		tflog.Debug(ctx, "failed to update the database topology", props)
+		resp.Diagnostics.AddError("failed to update the database topology", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "updated the database topology", props)
+}
+
+// Delete keeps the database topology, since the database is not managed by the resource.
+func (r *DatabaseTopologyResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// The topology is left as is: the database keeps serving, and there's no default topology to reset it to.
+}
+
+func (r *DatabaseTopologyResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("database"), req, resp)
+}
@@ -0,0 +1,16 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlterTopologyQuery(t *testing.T) {
+	assert.Equal(t, "ALTER DATABASE $name SET TOPOLOGY 3 PRIMARIES 0 SECONDARIES", alterTopologyQuery(3, 0))
+	assert.Equal(t, "ALTER DATABASE $name SET TOPOLOGY 1 PRIMARIES 2 SECONDARIES", alterTopologyQuery(1, 2))
+}
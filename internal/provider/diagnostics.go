@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	diagnosticOperationCreate = "create"
+	diagnosticOperationRead   = "read"
+	diagnosticOperationUpdate = "update"
+	diagnosticOperationDelete = "delete"
+	diagnosticOperationImport = "import"
+)
+
+// addOperationError adds the error of the failed operation on the entity to the diagnostics, and logs it.
+// The diagnostic carries the database, the entity's identifier and the operation,
+// to find the failed resource among many others applied at once.
+func (c *Client) addOperationError(ctx context.Context, diags *diag.Diagnostics, operation, entity, id string,
+	err error) {
+	fields := c.operationFields(operation, id)
+	tflog.Debug(ctx, "failed to "+operation+" the "+entity, fields)
+	diags.AddError("failed to "+operation+" the "+entity, fmt.Sprintf("%v\n\ndatabase: %s, uuid: %s, operation: %s",
+		err, fields["database"], id, operation))
+}
+
+// operationFields defines the structured fields of the operation on the entity.
+func (c *Client) operationFields(operation, id string) map[string]interface{} {
+	return map[string]interface{}{"database": c.databaseLabel(), "uuid": id, "operation": operation}
+}
+
+// databaseLabel names the database managed by the provider without querying it.
+// The database is not set when the provider uses the user's home database.
+func (c *Client) databaseLabel() string {
+	if c != nil && c.database != "" {
+		return c.database
+	}
+	return "<home>"
+}
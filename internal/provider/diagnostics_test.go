@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientAddOperationError(t *testing.T) {
+	var diags diag.Diagnostics
+	(&Client{database: "movies"}).addOperationError(context.Background(), &diags, diagnosticOperationCreate,
+		"node", "foo", errors.New("connection refused"))
+	assert.Len(t, diags, 1)
+	assert.Equal(t, "failed to create the node", diags[0].Summary())
+	assert.Equal(t, "connection refused\n\ndatabase: movies, uuid: foo, operation: create", diags[0].Detail())
+
+	assert.Equal(t, map[string]interface{}{"database": "<home>", "uuid": "bar", "operation": "delete"},
+		(&Client{}).operationFields(diagnosticOperationDelete, "bar"))
+}
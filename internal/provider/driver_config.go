@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// ModelDriver describes the advanced driver settings.
+type ModelDriver struct {
+	MaxConnectionPoolSize          types.Int64  `tfsdk:"max_connection_pool_size"`
+	MaxConnectionLifetime          types.String `tfsdk:"max_connection_lifetime"`
+	ConnectionAcquisitionTimeout   types.String `tfsdk:"connection_acquisition_timeout"`
+	ConnectionLivenessCheckTimeout types.String `tfsdk:"connection_liveness_check_timeout"`
+	SocketConnectTimeout           types.String `tfsdk:"socket_connect_timeout"`
+	SocketKeepalive                types.Bool   `tfsdk:"socket_keepalive"`
+	MaxTransactionRetryTime        types.String `tfsdk:"max_transaction_retry_time"`
+	FetchSize                      types.Int64  `tfsdk:"fetch_size"`
+	TelemetryDisabled              types.Bool   `tfsdk:"telemetry_disabled"`
+	TLSCACertificate               types.String `tfsdk:"tls_ca_certificate"`
+	TLSServerName                  types.String `tfsdk:"tls_server_name"`
+	ResolvedAddresses              types.List   `tfsdk:"resolved_addresses"`
+	LogLevel                       types.String `tfsdk:"log_level"`
+}
+
+// durationValidators validate the duration attributes, e.g. `30s`, or `1m30s`.
+var durationValidators = []validator.String{
+	stringvalidator.RegexMatches(regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`),
+		"shall be the duration, e.g. 30s, or 1m30s"),
+}
+
+// driverBlock defines the schema of the advanced driver settings.
+func driverBlock() schema.Block {
+	durationAttribute := func(description string) schema.Attribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			Validators:          durationValidators,
+		}
+	}
+
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "The advanced settings of the driver, the driver's defaults are used if not set, details: " +
+			"https://neo4j.com/docs/go-manual/current/connect-advanced/",
+		Attributes: map[string]schema.Attribute{
+			"max_connection_pool_size": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of the connections to a single server.",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"max_connection_lifetime": durationAttribute("The maximum time the connection is kept " +
+				"in the pool, e.g. `1h`."),
+			"connection_acquisition_timeout": durationAttribute("The maximum time to wait for a connection " +
+				"from the pool, e.g. `1m`."),
+			"connection_liveness_check_timeout": durationAttribute("The idle time after which the connection " +
+				"is checked before it's used, e.g. `5m`. Not checked if not set."),
+			"socket_connect_timeout": durationAttribute("The maximum time to establish the TCP connection, " +
+				"e.g. `5s`."),
+			"socket_keepalive": schema.BoolAttribute{
+				MarkdownDescription: "Set to false to disable the TCP keep-alive.",
+				Optional:            true,
+			},
+			"max_transaction_retry_time": durationAttribute("The maximum time to retry the transaction " +
+				"on the transient errors, e.g. `30s`."),
+			"fetch_size": schema.Int64Attribute{
+				MarkdownDescription: "The number of records fetched by the driver in a single batch " +
+					"when the query results are streamed, `-1` to fetch all records at once. " +
+					"Defaults to the driver's default of 1000.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Any(int64validator.AtLeast(1), int64validator.OneOf(neo4j.FetchAll)),
+				},
+			},
+			"telemetry_disabled": schema.BoolAttribute{
+				MarkdownDescription: "Set to disable the driver's telemetry, i.e. the anonymous usage statistics " +
+					"sent to the server, e.g. when it's forbidden by the compliance rules.",
+				Optional: true,
+			},
+			"tls_ca_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded certificate of the authority to verify the server's certificate by, " +
+					"e.g. the private CA. It's used with the `neo4j+s` and `bolt+s` URIs.",
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"tls_server_name": schema.StringAttribute{
+				MarkdownDescription: "The server name to verify the server's certificate for, " +
+					"e.g. when the server is connected via the proxy. It's used with the `neo4j+s` and `bolt+s` URIs.",
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"resolved_addresses": schema.ListAttribute{
+				MarkdownDescription: "The `host:port` addresses the initial address of the URI is resolved to, " +
+					"e.g. to bootstrap the routing from several cluster members.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+			},
+			"log_level": schema.StringAttribute{
+				MarkdownDescription: "The level of the driver's logs written to the provider's logs, " +
+					"e.g. to debug the Bolt handshake and the routing with `TF_LOG=DEBUG`: " +
+					"`OFF`, `ERROR`, `WARNING`, `INFO`, or `DEBUG`. Defaults to `OFF`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(driverLogLevelOff, driverLogLevelError, driverLogLevelWarning,
+						driverLogLevelInfo, driverLogLevelDebug),
+				},
+			},
+		},
+	}
+}
+
+// configurer defines the function to apply the settings to the driver's configuration.
+// The secrets are masked in the driver's logs.
+func (d *ModelDriver) configurer(ctx context.Context, secrets *secretMask) (func(*neo4j.Config), error) {
+	if d == nil {
+		return func(*neo4j.Config) {}, nil
+	}
+
+	var settings []func(*neo4j.Config)
+	var errs []error
+	for _, v := range []struct {
+		name  string
+		value types.String
+		set   func(*neo4j.Config, time.Duration)
+	}{
+		{"max_connection_lifetime", d.MaxConnectionLifetime,
+			func(c *neo4j.Config, v time.Duration) { c.MaxConnectionLifetime = v }},
+		{"connection_acquisition_timeout", d.ConnectionAcquisitionTimeout,
+			func(c *neo4j.Config, v time.Duration) { c.ConnectionAcquisitionTimeout = v }},
+		{"connection_liveness_check_timeout", d.ConnectionLivenessCheckTimeout,
+			func(c *neo4j.Config, v time.Duration) { c.ConnectionLivenessCheckTimeout = v }},
+		{"socket_connect_timeout", d.SocketConnectTimeout,
+			func(c *neo4j.Config, v time.Duration) { c.SocketConnectTimeout = v }},
+		{"max_transaction_retry_time", d.MaxTransactionRetryTime,
+			func(c *neo4j.Config, v time.Duration) { c.MaxTransactionRetryTime = v }},
+	} {
+		if v.value.IsNull() {
+			continue
+		}
+		duration, err := time.ParseDuration(v.value.ValueString())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid %s: %w", v.name, err))
+			continue
+		}
+		settings = append(settings, func(c *neo4j.Config) { v.set(c, duration) })
+	}
+
+	if !d.MaxConnectionPoolSize.IsNull() {
+		settings = append(settings, func(c *neo4j.Config) {
+			c.MaxConnectionPoolSize = int(d.MaxConnectionPoolSize.ValueInt64())
+		})
+	}
+	if !d.SocketKeepalive.IsNull() {
+		settings = append(settings, func(c *neo4j.Config) { c.SocketKeepalive = d.SocketKeepalive.ValueBool() })
+	}
+	if !d.FetchSize.IsNull() {
+		settings = append(settings, func(c *neo4j.Config) { c.FetchSize = int(d.FetchSize.ValueInt64()) })
+	}
+	if d.TelemetryDisabled.ValueBool() {
+		settings = append(settings, func(c *neo4j.Config) { c.TelemetryDisabled = true })
+	}
+
+	if logger := newDriverLogger(ctx, d.LogLevel.ValueString(), secrets); logger != nil {
+		settings = append(settings, func(c *neo4j.Config) { c.Log = logger })
+	}
+
+	if !d.TLSCACertificate.IsNull() || !d.TLSServerName.IsNull() {
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12, ServerName: d.TLSServerName.ValueString()}
+		if !d.TLSCACertificate.IsNull() {
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if !tlsConfig.RootCAs.AppendCertsFromPEM([]byte(d.TLSCACertificate.ValueString())) {
+				errs = append(errs, errors.New("invalid tls_ca_certificate: no PEM encoded certificate found"))
+			}
+		}
+		settings = append(settings, func(c *neo4j.Config) { c.TlsConfig = tlsConfig })
+	}
+
+	if !d.ResolvedAddresses.IsNull() {
+		var addresses []string
+		if diags := d.ResolvedAddresses.ElementsAs(ctx, &addresses, false); diags.HasError() {
+			errs = append(errs, fmt.Errorf("invalid resolved_addresses: %v", diags))
+		}
+		var resolved = make([]neo4j.ServerAddress, 0, len(addresses))
+		for _, address := range addresses {
+			if _, _, err := net.SplitHostPort(address); err != nil {
+				errs = append(errs, fmt.Errorf("invalid resolved_addresses: %w", err))
+				continue
+			}
+			// url.URL implements the server address' Hostname and Port methods.
+			resolved = append(resolved, &url.URL{Host: address})
+		}
+		settings = append(settings, func(c *neo4j.Config) {
+			c.AddressResolver = func(neo4j.ServerAddress) []neo4j.ServerAddress { return resolved }
+		})
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return func(c *neo4j.Config) {
+		for _, set := range settings {
+			set(c)
+		}
+	}, nil
+}
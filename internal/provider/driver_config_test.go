@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModelDriverConfigurer(t *testing.T) {
+	ctx := context.Background()
+
+	configurer, err := (*ModelDriver)(nil).configurer(ctx, nil)
+	assert.NoError(t, err)
+	var conf = neo4j.Config{FetchSize: 1000}
+	configurer(&conf)
+	assert.Equal(t, neo4j.Config{FetchSize: 1000}, conf, "the defaults shall be kept if not set")
+
+	configurer, err = (&ModelDriver{
+		MaxConnectionPoolSize:        types.Int64Value(10),
+		ConnectionAcquisitionTimeout: types.StringValue("1m"),
+		SocketKeepalive:              types.BoolValue(false),
+		FetchSize:                    types.Int64Value(-1),
+		TelemetryDisabled:            types.BoolValue(true),
+		TLSServerName:                types.StringValue("neo4j.internal"),
+		ResolvedAddresses: types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("core-1:7687"), types.StringValue("core-2:7687"),
+		}),
+	}).configurer(ctx, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	conf = neo4j.Config{SocketKeepalive: true}
+	configurer(&conf)
+	assert.Equal(t, 10, conf.MaxConnectionPoolSize)
+	assert.Equal(t, time.Minute, conf.ConnectionAcquisitionTimeout)
+	assert.False(t, conf.SocketKeepalive)
+	assert.Equal(t, neo4j.FetchAll, conf.FetchSize)
+	assert.True(t, conf.TelemetryDisabled)
+	assert.Equal(t, "neo4j.internal", conf.TlsConfig.ServerName)
+	if assert.NotNil(t, conf.AddressResolver) {
+		resolved := conf.AddressResolver(nil)
+		if assert.Len(t, resolved, 2) {
+			assert.Equal(t, "core-2", resolved[1].Hostname())
+			assert.Equal(t, "7687", resolved[1].Port())
+		}
+	}
+
+	_, err = (&ModelDriver{TLSCACertificate: types.StringValue("not a certificate")}).configurer(ctx, nil)
+	assert.ErrorContains(t, err, "tls_ca_certificate")
+
+	_, err = (&ModelDriver{ResolvedAddresses: types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("core-1"),
+	})}).configurer(ctx, nil)
+	assert.ErrorContains(t, err, "resolved_addresses")
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/log"
+)
+
+// The levels of the driver's logs.
+const (
+	driverLogLevelOff     = "OFF"
+	driverLogLevelError   = "ERROR"
+	driverLogLevelWarning = "WARNING"
+	driverLogLevelInfo    = "INFO"
+	driverLogLevelDebug   = "DEBUG"
+)
+
+// driverLogLevels maps the configured levels to the driver's levels.
+var driverLogLevels = map[string]log.Level{
+	driverLogLevelError:   log.ERROR,
+	driverLogLevelWarning: log.WARNING,
+	driverLogLevelInfo:    log.INFO,
+	driverLogLevelDebug:   log.DEBUG,
+}
+
+// driverLogger writes the driver's logs to tflog, hence they're shown with TF_LOG.
+type driverLogger struct {
+	// ctx holds the tflog logger of the provider.
+	ctx   context.Context
+	level log.Level
+	// secrets masks the values of the sensitive attributes in the messages.
+	secrets *secretMask
+}
+
+// newDriverLogger defines the logger, it's nil if the level is not set, or is OFF.
+func newDriverLogger(ctx context.Context, level string, secrets *secretMask) log.Logger {
+	v, ok := driverLogLevels[level]
+	if !ok {
+		return nil
+	}
+	return &driverLogger{ctx: ctx, level: v, secrets: secrets}
+}
+
+// driverLogFields defines the fields to identify the driver's component which logged the message.
+func driverLogFields(name, id string) map[string]interface{} {
+	return map[string]interface{}{"component": name, "id": id}
+}
+
+func (l *driverLogger) Error(name, id string, err error) {
+	if l.level >= log.ERROR {
+		tflog.Error(l.ctx, l.secrets.mask(err.Error()), driverLogFields(name, id))
+	}
+}
+
+func (l *driverLogger) Warnf(name, id string, msg string, args ...any) {
+	if l.level >= log.WARNING {
+		tflog.Warn(l.ctx, l.secrets.mask(fmt.Sprintf(msg, args...)), driverLogFields(name, id))
+	}
+}
+
+func (l *driverLogger) Infof(name, id string, msg string, args ...any) {
+	if l.level >= log.INFO {
+		tflog.Info(l.ctx, l.secrets.mask(fmt.Sprintf(msg, args...)), driverLogFields(name, id))
+	}
+}
+
+func (l *driverLogger) Debugf(name, id string, msg string, args ...any) {
+	if l.level >= log.DEBUG {
+		tflog.Debug(l.ctx, l.secrets.mask(fmt.Sprintf(msg, args...)), driverLogFields(name, id))
+	}
+}
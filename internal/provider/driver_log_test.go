@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDriverLogger(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &buf)
+
+	assert.Nil(t, newDriverLogger(ctx, "", nil))
+	assert.Nil(t, newDriverLogger(ctx, driverLogLevelOff, nil))
+
+	secrets := &secretMask{}
+	secrets.add("s3cr3t")
+	l := newDriverLogger(ctx, driverLogLevelWarning, secrets)
+	l.Error("router", "1", errors.New("no routing table"))
+	l.Warnf("pool", "2", "connection %s failed for s3cr3t", "c-1")
+	l.Infof("bolt5", "3", "handshake")
+	l.Debugf("bolt5", "3", "sent %d bytes", 10)
+
+	entries, err := tflogtest.MultilineJSONDecode(&buf)
+	if !assert.NoError(t, err) || !assert.Len(t, entries, 2) {
+		return
+	}
+	assert.Equal(t, "no routing table", entries[0]["@message"])
+	assert.Equal(t, "error", entries[0]["@level"])
+	assert.Equal(t, "router", entries[0]["component"])
+	assert.Equal(t, "connection c-1 failed for [REDACTED]", entries[1]["@message"])
+	assert.Equal(t, "warn", entries[1]["@level"])
+	assert.Equal(t, "2", entries[1]["id"])
+}
@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DVCatalogResource{}
+var _ resource.ResourceWithImportState = &DVCatalogResource{}
+
+func NewDVCatalogResource() resource.Resource {
+	return &DVCatalogResource{}
+}
+
+// DVCatalogResource defines the APOC data virtualization catalog entry resource implementation.
+type DVCatalogResource struct {
+	client *Client
+}
+
+// DVCatalogResourceModel describes the resource data model.
+type DVCatalogResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	URL         types.String `tfsdk:"url"`
+	Query       types.String `tfsdk:"query"`
+	Description types.String `tfsdk:"description"`
+	Labels      types.List   `tfsdk:"labels"`
+}
+
+const (
+	dvCatalogSuffix = "_dv_catalog"
+
+	dvTypeJDBC = "JDBC"
+	dvTypeCSV  = "CSV"
+)
+
+func (r *DVCatalogResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + dvCatalogSuffix
+}
+
+func (r *DVCatalogResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "APOC data virtualization catalog entry, i.e. the virtual resource, details: " +
+			"https://neo4j.com/labs/apoc/5/database-integration/data-virtualization/" +
+			"\n\n!>**Warning** The resource requires the APOC Extended plugin.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The virtual resource name.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The virtual resource type: `JDBC`, or `CSV`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(dvTypeJDBC, dvTypeCSV),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the data source.",
+				Required:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "The parametrised query to select the data, " +
+					"i.e. SQL for `JDBC`, or the filter expression, e.g. `map.name = $name` for `CSV`.",
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The virtual resource description.",
+				Optional:            true,
+			},
+			"labels": schema.ListAttribute{
+				MarkdownDescription: "The labels of the virtual nodes.",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *DVCatalogResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// install adds, or replaces the virtual resource in the catalog.
+func (r *DVCatalogResource) install(ctx context.Context, data *DVCatalogResourceModel) (diags diag.Diagnostics) {
+	var labels []string
+	diags.Append(data.Labels.ElementsAs(ctx, &labels, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if _, err := r.client.RunSystem(ctx, `CALL apoc.dv.catalog.install($name, $database,
+{type: $type, url: $url, query: $query, desc: $desc, labels: $labels})`, map[string]any{
+		"name":     data.Name.ValueString(),
+		"database": r.client.database,
+		"type":     data.Type.ValueString(),
+		"url":      data.URL.ValueString(),
+		"query":    data.Query.ValueString(),
+		"desc":     data.Description.ValueString(),
+		"labels":   labels,
+	}); err != nil {
+		diags.AddError("failed to install the virtual resource", err.Error())
+	}
+	return diags
+}
+
+func (r *DVCatalogResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+	var data DVCatalogResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+dvCatalogSuffix, auditOperationCreate, &data.Name)
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "install the virtual resource", props)
+	resp.Diagnostics.Append(r.install(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Debug(ctx, "failed to install the virtual resource", props)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "installed the virtual resource", props)
+}
+
+func (r *DVCatalogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DVCatalogResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "reading the virtual resource", props)
+
+	dbResp, err := r.client.RunSystem(ctx, `CALL apoc.dv.catalog.show($database)
+YIELD name, type, url, desc, labels, query
+WHERE name = $name
+RETURN type, url, desc, labels, query`, map[string]any{
+		"name":     data.Name.ValueString(),
+		"database": r.client.database,
+	})
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the virtual resource", props)
+		resp.Diagnostics.AddError("failed to read the virtual resource", err.Error())
+		return
+	}
+	if len(dbResp.Records) == 0 {
+		tflog.Debug(ctx, "no virtual resource found", props)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	m := dbResp.Records[0].AsMap()
+	data.Type = toStringValue(m["type"])
+	data.URL = toStringValue(m["url"])
+	data.Query = toStringValue(m["query"])
+	if desc := toStringValue(m["desc"]); !(data.Description.IsNull() && desc.ValueString() == "") {
+		data.Description = desc
+	}
+
+	var labels []string
+	if v, ok := m["labels"].([]any); ok {
+		for _, l := range v {
+			labels = append(labels, fmt.Sprintf("%v", l))
+		}
+	}
+	var diags diag.Diagnostics
+	data.Labels, diags = types.ListValueFrom(ctx, types.StringType, labels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the virtual resource", props)
+}
+
+func (r *DVCatalogResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+	var data DVCatalogResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+dvCatalogSuffix, auditOperationUpdate, &data.Name)
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "updating the virtual resource", props)
+	resp.Diagnostics.Append(r.install(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Debug(ctx, "failed to update the virtual resource", props)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "updated the virtual resource", props)
+}
+
+func (r *DVCatalogResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+	var data DVCatalogResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+dvCatalogSuffix, auditOperationDelete, &data.Name)
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "delete the virtual resource", props)
+	if _, err := r.client.RunSystem(ctx, `CALL apoc.dv.catalog.drop($name, $database)`, map[string]any{
+		"name":     data.Name.ValueString(),
+		"database": r.client.database,
+	}); err != nil {
+		tflog.Debug(ctx, "failed to delete the virtual resource", props)
+		resp.Diagnostics.AddError("failed to delete the virtual resource", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "deleted the virtual resource", props)
+}
+
+func (r *DVCatalogResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
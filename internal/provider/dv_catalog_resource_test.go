@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDVCatalogResource(t *testing.T) {
+	t.Setenv("DB_URI", testDbURI)
+	t.Setenv("DB_USER", testDBUser)
+	t.Cleanup(func() {
+		t.Setenv("DB_URI", "")
+		t.Setenv("DB_USER", "")
+	})
+
+	// The test container runs APOC Core without the APOC Extended procedures,
+	// hence the installation shall fail without leaving the resource in the state.
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "neo4j_dv_catalog" "_" {
+name   = "people"
+type   = "CSV"
+url    = "file:///people.csv"
+query  = "map.name = $name"
+labels = ["Person"]
+}`,
+				ExpectError: regexp.MustCompile("failed to install the virtual resource"),
+			},
+		},
+	})
+}
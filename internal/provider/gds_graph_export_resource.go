@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GDSGraphExportResource{}
+
+func NewGDSGraphExportResource() resource.Resource {
+	return &GDSGraphExportResource{}
+}
+
+// GDSGraphExportResource defines the resource to materialise a GDS in-memory graph to a new database.
+type GDSGraphExportResource struct {
+	client *Client
+}
+
+// GDSGraphExportResourceModel describes the resource data model.
+type GDSGraphExportResourceModel struct {
+	GraphName         types.String `tfsdk:"graph_name"`
+	DatabaseName      types.String `tfsdk:"database_name"`
+	NodeCount         types.Int64  `tfsdk:"node_count"`
+	RelationshipCount types.Int64  `tfsdk:"relationship_count"`
+}
+
+const gdsGraphExportSuffix = "_gds_graph_export"
+
+func (r *GDSGraphExportResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + gdsGraphExportSuffix
+}
+
+func (r *GDSGraphExportResource) Schema(_ context.Context, _ resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Exports the Graph Data Science in-memory graph to a new database, details: " +
+			"https://neo4j.com/docs/graph-data-science/current/management-ops/graph-creation/graph-export/" +
+			"\n\n!>**Warning** The resource requires the GDS plugin and the Neo4j Enterprise Edition. " +
+			"The database is dropped when the resource is destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"graph_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the projected in-memory graph to export.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"database_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the database to export the graph to. It must not exist.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"node_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of exported nodes.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"relationship_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of exported relationships.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *GDSGraphExportResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GDSGraphExportResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+	var data GDSGraphExportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+gdsGraphExportSuffix, auditOperationCreate, &data.DatabaseName)
+
+	props := map[string]interface{}{"graph": data.GraphName.ValueString(), "database": data.DatabaseName.ValueString()}
+	tflog.Trace(ctx, "export the gds graph", props)
+
+	dbResp, err := r.client.Run(ctx, `CALL gds.graph.export($graph, {dbName: $database})
+YIELD nodeCount, relationshipCount
+RETURN nodeCount, relationshipCount`, map[string]any{
+		"graph":    data.GraphName.ValueString(),
+		"database": data.DatabaseName.ValueString(),
+	})
+	if err != nil {
+		tflog.Debug(ctx, "failed to export the gds graph", props)
+		resp.Diagnostics.AddError("failed to export the gds graph", err.Error())
+		return
+	}
+	rec, err := dbResp.Single(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "failed to export the gds graph", props)
+		resp.Diagnostics.AddError("failed to export the gds graph", err.Error())
+		return
+	}
+	m := rec.AsMap()
+	nodeCount, okNodes := m["nodeCount"].(int64)
+	relationshipCount, okRelationships := m["relationshipCount"].(int64)
+	if !okNodes || !okRelationships {
+		tflog.Debug(ctx, "unexpected gds graph export result", props)
+		resp.Diagnostics.AddError("unexpected gds graph export result", fmt.Sprintf("%v", m))
+		return
+	}
+	data.NodeCount = types.Int64Value(nodeCount)
+	data.RelationshipCount = types.Int64Value(relationshipCount)
+
+	// The state is saved before the database is created to keep track of the exported store:
+	// the resource is tainted if the database creation fails, hence the next apply replaces it.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The exported database's files are only written by gds, the database itself must be created.
+	if _, err := r.client.RunSystem(ctx, `CREATE DATABASE $database IF NOT EXISTS WAIT`,
+		map[string]any{"database": data.DatabaseName.ValueString()}); err != nil {
+		tflog.Debug(ctx, "failed to create the exported database", props)
+		resp.Diagnostics.AddError("failed to create the exported database",
+			fmt.Sprintf("the graph was exported, but the database could not be created: %v. "+
+				"Run `CREATE DATABASE %s` to register the exported store, it will be replaced on the next apply.",
+				err, data.DatabaseName.ValueString()))
+		return
+	}
+
+	tflog.Trace(ctx, "exported the gds graph", props)
+}
+
+func (r *GDSGraphExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GDSGraphExportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	props := map[string]interface{}{"database": data.DatabaseName.ValueString()}
+	tflog.Trace(ctx, "reading the exported database", props)
+
+	dbResp, err := r.client.RunSystem(ctx, `SHOW DATABASE $database YIELD name RETURN name`,
+		map[string]any{"database": data.DatabaseName.ValueString()})
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the exported database", props)
+		resp.Diagnostics.AddError("failed to read the exported database", err.Error())
+		return
+	}
+	if len(dbResp.Records) == 0 {
+		tflog.Debug(ctx, "no exported database found", props)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the exported database", props)
+}
+
+func (r *GDSGraphExportResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+	// All configurable attributes require replacement, hence the plan is only copied to the state.
+	var data GDSGraphExportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GDSGraphExportResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+	var data GDSGraphExportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+gdsGraphExportSuffix, auditOperationDelete, &data.DatabaseName)
+
+	props := map[string]interface{}{"database": data.DatabaseName.ValueString()}
+	tflog.Trace(ctx, "delete the exported database", props)
+	if _, err := r.client.RunSystem(ctx, `DROP DATABASE $database IF EXISTS WAIT`,
+		map[string]any{"database": data.DatabaseName.ValueString()}); err != nil {
+		tflog.Debug(ctx, "failed to delete the exported database", props)
+		resp.Diagnostics.AddError("failed to delete the exported database", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "deleted the exported database", props)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGDSGraphExportResource(t *testing.T) {
+	t.Setenv("DB_URI", testDbURI)
+	t.Setenv("DB_USER", testDBUser)
+	t.Cleanup(func() {
+		t.Setenv("DB_URI", "")
+		t.Setenv("DB_USER", "")
+	})
+
+	// The test container runs the Community Edition without the GDS plugin,
+	// hence the export shall fail without leaving the resource in the state.
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "neo4j_gds_graph_export" "_" {
+graph_name    = "projection"
+database_name = "analytics"
+}`,
+				ExpectError: regexp.MustCompile("failed to export the gds graph"),
+			},
+		},
+	})
+}
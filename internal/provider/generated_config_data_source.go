@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GeneratedConfigDataSource{}
+
+func NewGeneratedConfigDataSource() datasource.DataSource {
+	return &GeneratedConfigDataSource{}
+}
+
+// GeneratedConfigDataSource defines the data source to generate the configuration of the existing graph.
+type GeneratedConfigDataSource struct {
+	client *Client
+}
+
+// GeneratedConfigDataSourceModel describes the data source data model.
+type GeneratedConfigDataSourceModel struct {
+	Labels        types.List   `tfsdk:"labels"`
+	HCL           types.String `tfsdk:"hcl"`
+	Nodes         types.Int64  `tfsdk:"nodes"`
+	Relationships types.Int64  `tfsdk:"relationships"`
+}
+
+const generatedConfigSuffix = "_generated_config"
+
+const (
+	generatedConfigNodesQuery = `MATCH (n)
+WHERE any(l IN labels(n) WHERE l IN $labels)
+RETURN n
+ORDER BY n.uuid, elementId(n)`
+	generatedConfigRelationshipsQuery = `MATCH (s)-[r]->(e)
+WHERE r.uuid IS NOT NULL AND s.uuid IS NOT NULL AND e.uuid IS NOT NULL
+  AND any(l IN labels(s) WHERE l IN $labels) AND any(l IN labels(e) WHERE l IN $labels)
+RETURN s.uuid, e.uuid, r
+ORDER BY r.uuid`
+)
+
+func (d *GeneratedConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + generatedConfigSuffix
+}
+
+func (d *GeneratedConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates the configuration of the existing nodes and relationships " +
+			"to bring them under Terraform management." +
+			"\n\n-> **Note** The `import` blocks are generated for the entities which have the `uuid` property. " +
+			"The nodes without it are adopted on create using their properties as the selector. " +
+			"The relationships without it and the nodes without properties are skipped.",
+		Attributes: map[string]schema.Attribute{
+			"labels": schema.ListAttribute{
+				MarkdownDescription: "The labels of the nodes to generate the configuration for.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+			},
+			"hcl": schema.StringAttribute{
+				MarkdownDescription: "The generated configuration.",
+				Computed:            true,
+			},
+			"nodes": schema.Int64Attribute{
+				MarkdownDescription: "The number of the nodes in the generated configuration.",
+				Computed:            true,
+			},
+			"relationships": schema.Int64Attribute{
+				MarkdownDescription: "The number of the relationships in the generated configuration.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GeneratedConfigDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// hclString quotes the string to be used in HCL, including the template sequences.
+func hclString(s string) string {
+	s = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`,
+		"${", "$${", "%{", "%%{").Replace(s)
+	return `"` + s + `"`
+}
+
+// hclName defines the resource name, the characters not allowed in the HCL identifiers are replaced.
+func hclName(prefix, id string) string {
+	return prefix + strings.Map(func(r rune) rune {
+		if r == '-' || r == '_' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9') {
+			return r
+		}
+		return '_'
+	}, id)
+}
+
+// hclStringList formats the list of strings as HCL.
+func hclStringList(v []string) string {
+	var o = make([]string, len(v))
+	for i, s := range v {
+		o[i] = hclString(s)
+	}
+	return "[" + strings.Join(o, ", ") + "]"
+}
+
+// hclProperties formats the properties as HCL map, the identity property is skipped.
+func hclProperties(properties map[string]any) string {
+	var keys []string
+	for k := range properties {
+		if k != "uuid" {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	var o strings.Builder
+	o.WriteString("{\n")
+	for _, k := range keys {
+		o.WriteString("    " + hclString(k) + " = " + hclString(formatProperty(properties[k])) + "\n")
+	}
+	o.WriteString("  }")
+	return o.String()
+}
+
+// hclNode generates the configuration of the node.
+// The node is skipped if it's not managed and has no properties to select it by to adopt.
+func hclNode(o *strings.Builder, name string, node neo4j.Node) bool {
+	properties := node.GetProperties()
+	id, managed := properties["uuid"].(string)
+	if !managed && len(properties) == 0 {
+		return false
+	}
+	if managed {
+		fmt.Fprintf(o, "import {\n  to = %s.%s\n  id = %s\n}\n\n", Name+nodeSuffix, name, hclString(id))
+	}
+
+	fmt.Fprintf(o, "resource %s %s {\n", hclString(Name+nodeSuffix), hclString(name))
+	if len(node.Labels) > 0 {
+		fmt.Fprintf(o, "  labels = %s\n", hclStringList(node.Labels))
+	}
+	if len(properties) > 0 && !(managed && len(properties) == 1) {
+		fmt.Fprintf(o, "  properties = %s\n", hclProperties(properties))
+	}
+	if !managed {
+		fmt.Fprintf(o, "  adopt_if_exists = true\n  adopt_selector = %s\n", hclProperties(properties))
+	}
+	o.WriteString("}\n\n")
+	return true
+}
+
+// hclRelationship generates the configuration of the relationship.
+func hclRelationship(o *strings.Builder, name, startNode, endNode string, relationship neo4j.Relationship) {
+	properties := relationship.GetProperties()
+	fmt.Fprintf(o, "import {\n  to = %s.%s\n  id = %s\n}\n\n", Name+edgeSuffix, name,
+		hclString(fmt.Sprintf("%v", properties["uuid"])))
+	fmt.Fprintf(o, "resource %s %s {\n", hclString(Name+edgeSuffix), hclString(name))
+	fmt.Fprintf(o, "  type = %s\n", hclString(relationship.Type))
+	fmt.Fprintf(o, "  start_node_id = %s.%s.id\n", Name+nodeSuffix, startNode)
+	fmt.Fprintf(o, "  end_node_id = %s.%s.id\n", Name+nodeSuffix, endNode)
+	if len(properties) > 1 {
+		fmt.Fprintf(o, "  properties = %s\n", hclProperties(properties))
+	}
+	o.WriteString("}\n\n")
+}
+
+func (d *GeneratedConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+	var data GeneratedConfigDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var labels []string
+	resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &labels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "generating the configuration")
+
+	nodes, err := d.client.RunRead(ctx, generatedConfigNodesQuery, map[string]any{"labels": labels})
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the nodes")
+		resp.Diagnostics.AddError("failed to read the nodes", err.Error())
+		return
+	}
+	relationships, err := d.client.RunRead(ctx, generatedConfigRelationshipsQuery, map[string]any{"labels": labels})
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the relationships")
+		resp.Diagnostics.AddError("failed to read the relationships", err.Error())
+		return
+	}
+
+	var o strings.Builder
+	// The resources are named after the uuid to keep the names stable when the graph changes.
+	var names = map[string]string{}
+	var nodesCount int64
+	for i, rec := range nodes.Records {
+		node, ok := rec.Values[0].(neo4j.Node)
+		if !ok {
+			continue
+		}
+		name := fmt.Sprintf("node_%d", i)
+		if id, ok := node.GetProperties()["uuid"].(string); ok {
+			name = hclName("node_", id)
+			names[id] = name
+		}
+		if hclNode(&o, name, node) {
+			nodesCount++
+		}
+	}
+
+	var relationshipsCount int64
+	for _, rec := range relationships.Records {
+		start, _ := rec.Values[0].(string)
+		end, _ := rec.Values[1].(string)
+		relationship, ok := rec.Values[2].(neo4j.Relationship)
+		if !ok || names[start] == "" || names[end] == "" {
+			continue
+		}
+		hclRelationship(&o, hclName("relationship_", fmt.Sprintf("%v", relationship.GetProperties()["uuid"])),
+			names[start], names[end], relationship)
+		relationshipsCount++
+	}
+
+	data.HCL = types.StringValue(strings.TrimSuffix(o.String(), "\n"))
+	data.Nodes = types.Int64Value(nodesCount)
+	data.Relationships = types.Int64Value(relationshipsCount)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "generated the configuration")
+}
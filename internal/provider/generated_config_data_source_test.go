@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHCLString(t *testing.T) {
+	assert.Equal(t, `"foo"`, hclString("foo"))
+	assert.Equal(t, `"say \"hi\"\n"`, hclString("say \"hi\"\n"))
+	assert.Equal(t, `"$${var.foo} %%{if}"`, hclString("${var.foo} %{if}"))
+	assert.Equal(t, `"C:\\path"`, hclString(`C:\path`))
+}
+
+func TestHCLName(t *testing.T) {
+	assert.Equal(t, "node_0b6c-4f", hclName("node_", "0b6c-4f"))
+	assert.Equal(t, "node_a_b_c", hclName("node_", "a b.c"))
+}
+
+func TestAccGeneratedConfigDataSource(t *testing.T) {
+	t.Setenv("DB_URI", testDbURI)
+	t.Setenv("DB_USER", testDBUser)
+	t.Cleanup(func() {
+		t.Setenv("DB_URI", "")
+		t.Setenv("DB_USER", "")
+	})
+
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err != nil {
+		t.Errorf("could not conenct to database: %v\n", err)
+		return
+	}
+	t.Cleanup(func() { _ = c.Close(ctx) })
+
+	if _, err = c.Run(ctx, `CREATE (a:Brownfield{uuid:"bf-a", name:"a"}), (b:Brownfield{uuid:"bf-b"}),
+(:Brownfield{name:"unmanaged"}), (:Brownfield), (a)-[:BF_LINK{uuid:"bf-link", weight:2}]->(b)`,
+		nil); err != nil {
+		t.Errorf("could not seed the graph: %v\n", err)
+		return
+	}
+	t.Cleanup(func() {
+		_, _ = c.Run(ctx, `MATCH (n:Brownfield) DETACH DELETE n`, nil)
+	})
+
+	const dataSourceAddress = "data." + Name + generatedConfigSuffix + "._"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "neo4j_generated_config" "_" {
+labels = ["Brownfield"]
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("nodes"),
+						knownvalue.Int64Exact(3)),
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("relationships"),
+						knownvalue.Int64Exact(1)),
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("hcl"),
+						knownvalue.StringRegexp(regexp.MustCompile(
+							`(?s)import \{\n  to = neo4j_node.node_bf-a\n  id = "bf-a"\n}.*`+
+								`adopt_if_exists = true.*`+
+								`start_node_id = neo4j_node.node_bf-a.id\n  end_node_id = neo4j_node.node_bf-b.id`,
+						))),
+				},
+			},
+		},
+	})
+}
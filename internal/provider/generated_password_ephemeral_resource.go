@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &GeneratedPasswordEphemeralResource{}
+
+func NewGeneratedPasswordEphemeralResource() ephemeral.EphemeralResource {
+	return &GeneratedPasswordEphemeralResource{}
+}
+
+// GeneratedPasswordEphemeralResource defines the ephemeral resource to generate the password
+// which is neither stored in the configuration, nor in the state.
+type GeneratedPasswordEphemeralResource struct{}
+
+// GeneratedPasswordEphemeralResourceModel describes the ephemeral resource data model.
+type GeneratedPasswordEphemeralResourceModel struct {
+	Length          types.Int64  `tfsdk:"length"`
+	Lower           types.Bool   `tfsdk:"lower"`
+	Upper           types.Bool   `tfsdk:"upper"`
+	Numeric         types.Bool   `tfsdk:"numeric"`
+	Special         types.Bool   `tfsdk:"special"`
+	OverrideSpecial types.String `tfsdk:"override_special"`
+	Result          types.String `tfsdk:"result"`
+}
+
+const (
+	generatedPasswordSuffix = "_generated_password"
+
+	generatedPasswordDefaultLength = 24
+
+	passwordCharsLower   = "abcdefghijklmnopqrstuvwxyz"
+	passwordCharsUpper   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	passwordCharsNumeric = "0123456789"
+	passwordCharsSpecial = "!#$%&*()-_=+[]{}<>:?"
+)
+
+// randomIndex picks the random index below n.
+func randomIndex(n int) (int, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+	return int(i.Int64()), nil
+}
+
+// generatePassword generates the password of the given length which contains
+// at least one character of every character set.
+func generatePassword(length int, charsets []string) (string, error) {
+	if len(charsets) == 0 {
+		return "", errors.New("at least one character set shall be enabled")
+	}
+	if length < len(charsets) {
+		return "", fmt.Errorf("the length shall be at least %d to include every enabled character set", len(charsets))
+	}
+
+	// The characters are picked as runes, because the special characters may be non-ASCII.
+	var password = make([]rune, 0, length)
+	for _, charset := range charsets {
+		chars := []rune(charset)
+		i, err := randomIndex(len(chars))
+		if err != nil {
+			return "", err
+		}
+		password = append(password, chars[i])
+	}
+
+	all := []rune(strings.Join(charsets, ""))
+	for len(password) < length {
+		i, err := randomIndex(len(all))
+		if err != nil {
+			return "", err
+		}
+		password = append(password, all[i])
+	}
+
+	// The characters of the mandatory sets are shuffled, otherwise they'd always lead the password.
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := randomIndex(i + 1)
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j] = password[j], password[i]
+	}
+	return string(password), nil
+}
+
+func (r *GeneratedPasswordEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest,
+	resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + generatedPasswordSuffix
+}
+
+func (r *GeneratedPasswordEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest,
+	resp *ephemeral.SchemaResponse) {
+	charsetAttribute := func(description string) schema.Attribute {
+		return schema.BoolAttribute{
+			MarkdownDescription: description + " Defaults to true.",
+			Optional:            true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates the random password which is neither stored in the configuration, " +
+			"nor in the state, e.g. to pass it to the ephemeral, or write-only attributes. " +
+			"The password contains at least one character of every enabled character set." +
+			"\n\n-> **Note** The new password is generated on every Terraform run.",
+		Attributes: map[string]schema.Attribute{
+			"length": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The password's length. Defaults to %d.",
+					generatedPasswordDefaultLength),
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.Between(8, 256)},
+			},
+			"lower":   charsetAttribute("Set to include the lowercase letters."),
+			"upper":   charsetAttribute("Set to include the uppercase letters."),
+			"numeric": charsetAttribute("Set to include the digits."),
+			"special": charsetAttribute("Set to include the special characters."),
+			"override_special": schema.StringAttribute{
+				MarkdownDescription: "The special characters to use instead of the default `" +
+					passwordCharsSpecial + "`, e.g. to comply with the policy of the application.",
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"result": schema.StringAttribute{
+				MarkdownDescription: "The generated password.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *GeneratedPasswordEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest,
+	resp *ephemeral.OpenResponse) {
+	var data GeneratedPasswordEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var charsets []string
+	for _, v := range []struct {
+		enabled types.Bool
+		chars   string
+	}{
+		{data.Lower, passwordCharsLower},
+		{data.Upper, passwordCharsUpper},
+		{data.Numeric, passwordCharsNumeric},
+		{data.Special, passwordCharsSpecial},
+	} {
+		if !v.enabled.IsNull() && !v.enabled.ValueBool() {
+			continue
+		}
+		if v.chars == passwordCharsSpecial && !data.OverrideSpecial.IsNull() {
+			v.chars = data.OverrideSpecial.ValueString()
+		}
+		charsets = append(charsets, v.chars)
+	}
+
+	length := data.Length.ValueInt64()
+	if data.Length.IsNull() {
+		length = generatedPasswordDefaultLength
+	}
+
+	tflog.Trace(ctx, "generating the password", map[string]interface{}{"length": length})
+	password, err := generatePassword(int(length), charsets)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to generate the password", err.Error())
+		return
+	}
+
+	data.Result = types.StringValue(password)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	tflog.Trace(ctx, "generated the password")
+}
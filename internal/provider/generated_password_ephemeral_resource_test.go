@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneratePassword(t *testing.T) {
+	charsets := []string{passwordCharsLower, passwordCharsUpper, passwordCharsNumeric, "@"}
+	for range 100 {
+		password, err := generatePassword(8, charsets)
+		if !assert.NoError(t, err) || !assert.Len(t, password, 8) {
+			return
+		}
+		for _, charset := range charsets {
+			assert.True(t, strings.ContainsAny(password, charset),
+				"expected the password %q to contain any of %q", password, charset)
+		}
+		assert.False(t, strings.ContainsAny(password, passwordCharsSpecial))
+	}
+
+	a, _ := generatePassword(24, charsets)
+	b, _ := generatePassword(24, charsets)
+	assert.NotEqual(t, a, b)
+
+	// the non-ASCII special characters are picked whole
+	for range 100 {
+		password, err := generatePassword(12, []string{passwordCharsLower, "äß€"})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.True(t, utf8.ValidString(password), "expected the valid UTF-8 password, got %q", password)
+		assert.Equal(t, 12, utf8.RuneCountInString(password))
+		assert.True(t, strings.ContainsAny(password, "äß€"))
+	}
+
+	_, err := generatePassword(8, nil)
+	assert.ErrorContains(t, err, "at least one character set")
+
+	_, err = generatePassword(3, charsets)
+	assert.ErrorContains(t, err, "the length shall be at least 4")
+}
@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GraphChecksumDataSource{}
+
+func NewGraphChecksumDataSource() datasource.DataSource {
+	return &GraphChecksumDataSource{}
+}
+
+// GraphChecksumDataSource defines the data source to calculate the checksum of the subgraph.
+type GraphChecksumDataSource struct {
+	client *Client
+}
+
+// GraphChecksumDataSourceModel describes the data source data model.
+type GraphChecksumDataSourceModel struct {
+	Labels            types.List   `tfsdk:"labels"`
+	RelationshipTypes types.List   `tfsdk:"relationship_types"`
+	Properties        types.List   `tfsdk:"properties"`
+	Checksum          types.String `tfsdk:"checksum"`
+	Nodes             types.Int64  `tfsdk:"nodes"`
+	Relationships     types.Int64  `tfsdk:"relationships"`
+}
+
+const graphChecksumSuffix = "_graph_checksum"
+
+const (
+	graphChecksumNodesQuery = `MATCH (n)
+WHERE size($labels) = 0 OR any(l IN labels(n) WHERE l IN $labels)
+RETURN elementId(n), labels(n), properties(n)`
+	graphChecksumRelationshipsQuery = `MATCH (s)-[r]->(e)
+WHERE (size($types) = 0 OR type(r) IN $types)
+  AND (size($labels) = 0 OR (any(l IN labels(s) WHERE l IN $labels) AND any(l IN labels(e) WHERE l IN $labels)))
+RETURN elementId(s), elementId(e), type(r), properties(r)`
+)
+
+func (d *GraphChecksumDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + graphChecksumSuffix
+}
+
+func (d *GraphChecksumDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Calculates the deterministic checksum of the subgraph, " +
+			"e.g. to compare the graphs seeded to different environments." +
+			"\n\n-> **Note** The checksum does not depend on the internal identifiers and " +
+			"on the `uuid` property used by the provider to identify the resources.",
+		Attributes: map[string]schema.Attribute{
+			"labels": schema.ListAttribute{
+				MarkdownDescription: "The labels of the nodes to include. All nodes are included if not set.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"relationship_types": schema.ListAttribute{
+				MarkdownDescription: "The types of the relationships between the included nodes to include. " +
+					"All relationships are included if not set.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"properties": schema.ListAttribute{
+				MarkdownDescription: "The properties to include. All properties are included if not set.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"checksum": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 checksum of the subgraph.",
+				Computed:            true,
+			},
+			"nodes": schema.Int64Attribute{
+				MarkdownDescription: "The number of the included nodes.",
+				Computed:            true,
+			},
+			"relationships": schema.Int64Attribute{
+				MarkdownDescription: "The number of the included relationships.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GraphChecksumDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// entityDigest calculates the checksum of the entity's canonical JSON representation.
+func entityDigest(v map[string]any) (string, error) {
+	// The map keys are sorted when encoded, hence the encoding is deterministic.
+	o, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.Sum256(o)
+	return hex.EncodeToString(h[:]), nil
+}
+
+// selectProperties removes the identity property and the properties which are not selected.
+func selectProperties(properties map[string]any, selected []string) map[string]any {
+	var o = make(map[string]any, len(properties))
+	for k, v := range properties {
+		if k != "uuid" && (len(selected) == 0 || slices.Contains(selected, k)) {
+			o[k] = v
+		}
+	}
+	return o
+}
+
+// graphDigests calculates the checksums of the nodes and the relationships of the subgraph.
+func graphDigests(ctx context.Context, tx neo4j.ManagedTransaction, labels, relationshipTypes,
+	properties []string) (nodes, relationships []string, err error) {
+	dbResp, err := tx.Run(ctx, graphChecksumNodesQuery, map[string]any{"labels": labels})
+	if err != nil {
+		return nil, nil, err
+	}
+	// The nodes are referenced by their digest to make the relationships' digests independent of the internal ids.
+	var nodeDigests = map[string]string{}
+	var rec *neo4j.Record
+	for dbResp.NextRecord(ctx, &rec) {
+		id, _ := rec.Values[0].(string)
+		nodeLabels, _ := rec.Values[1].([]any)
+		slices.SortFunc(nodeLabels, func(a, b any) int { return strings.Compare(fmt.Sprint(a), fmt.Sprint(b)) })
+		props, _ := rec.Values[2].(map[string]any)
+		digest, err := entityDigest(map[string]any{
+			"labels":     nodeLabels,
+			"properties": selectProperties(props, properties),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		nodeDigests[id] = digest
+		nodes = append(nodes, digest)
+	}
+	if err := dbResp.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	dbResp, err = tx.Run(ctx, graphChecksumRelationshipsQuery, map[string]any{
+		"labels": labels,
+		"types":  relationshipTypes,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	for dbResp.NextRecord(ctx, &rec) {
+		start, _ := rec.Values[0].(string)
+		end, _ := rec.Values[1].(string)
+		props, _ := rec.Values[3].(map[string]any)
+		digest, err := entityDigest(map[string]any{
+			"start":      nodeDigests[start],
+			"end":        nodeDigests[end],
+			"type":       rec.Values[2],
+			"properties": selectProperties(props, properties),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		relationships = append(relationships, digest)
+	}
+	return nodes, relationships, dbResp.Err()
+}
+
+func (d *GraphChecksumDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+	var data GraphChecksumDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The lists are passed to the queries as empty lists if not set.
+	var labels, relationshipTypes, properties = make([]string, 0), make([]string, 0), make([]string, 0)
+	for _, v := range []struct {
+		list   types.List
+		target *[]string
+	}{
+		{data.Labels, &labels},
+		{data.RelationshipTypes, &relationshipTypes},
+		{data.Properties, &properties},
+	} {
+		if !v.list.IsNull() {
+			resp.Diagnostics.Append(v.list.ElementsAs(ctx, v.target, false)...)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "calculating the graph checksum")
+
+	var nodes, relationships []string
+	// The nodes and the relationships are read in the same transaction to refer to the nodes by their internal ids.
+	if _, err := d.client.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		var err error
+		nodes, relationships, err = graphDigests(ctx, tx, labels, relationshipTypes, properties)
+		return nil, err
+	}); err != nil {
+		tflog.Debug(ctx, "failed to read the graph")
+		resp.Diagnostics.AddError("failed to calculate the graph checksum", err.Error())
+		return
+	}
+
+	// The digests are sorted to make the checksum independent of the order the entities are read in.
+	slices.Sort(nodes)
+	slices.Sort(relationships)
+	h := sha256.New()
+	for _, digest := range append(nodes, relationships...) {
+		h.Write([]byte(digest))
+	}
+
+	data.Checksum = types.StringValue(hex.EncodeToString(h.Sum(nil)))
+	data.Nodes = types.Int64Value(int64(len(nodes)))
+	data.Relationships = types.Int64Value(int64(len(relationships)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "calculated the graph checksum")
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccGraphChecksumDataSource(t *testing.T) {
+	t.Setenv("DB_URI", testDbURI)
+	t.Setenv("DB_USER", testDBUser)
+	t.Cleanup(func() {
+		t.Setenv("DB_URI", "")
+		t.Setenv("DB_USER", "")
+	})
+
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err != nil {
+		t.Errorf("could not conenct to database: %v\n", err)
+		return
+	}
+	t.Cleanup(func() { _ = c.Close(ctx) })
+
+	seed := func(uuidPrefix, name string) {
+		if _, err := c.Run(ctx, `MATCH (n:Checksum) DETACH DELETE n`, nil); err != nil {
+			t.Fatalf("could not clean the graph: %v", err)
+		}
+		if _, err := c.Run(ctx, `CREATE (a:Checksum{uuid:$prefix + "a", name:$name}),
+(b:Checksum{uuid:$prefix + "b", name:"b"}), (a)-[:CHECKSUM_LINK{weight:1}]->(b)`,
+			map[string]any{"prefix": uuidPrefix, "name": name}); err != nil {
+			t.Fatalf("could not seed the graph: %v", err)
+		}
+	}
+	seed("staging-", "a")
+	t.Cleanup(func() {
+		_, _ = c.Run(ctx, `MATCH (n:Checksum) DETACH DELETE n`, nil)
+	})
+
+	const (
+		dataSourceAddress = "data." + Name + graphChecksumSuffix + "._"
+		config            = `data "neo4j_graph_checksum" "_" {
+labels = ["Checksum"]
+}`
+	)
+
+	same := statecheck.CompareValue(compare.ValuesSame())
+	differ := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("checksum"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^[0-9a-f]{64}$`))),
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("nodes"),
+						knownvalue.Int64Exact(2)),
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("relationships"),
+						knownvalue.Int64Exact(1)),
+					same.AddStateValue(dataSourceAddress, tfjsonpath.New("checksum")),
+					differ.AddStateValue(dataSourceAddress, tfjsonpath.New("checksum")),
+				},
+			},
+			{
+				// The same graph seeded with other identifiers.
+				PreConfig: func() { seed("prod-", "a") },
+				Config:    config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					same.AddStateValue(dataSourceAddress, tfjsonpath.New("checksum")),
+				},
+			},
+			{
+				PreConfig: func() { seed("prod-", "changed") },
+				Config:    config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					differ.AddStateValue(dataSourceAddress, tfjsonpath.New("checksum")),
+				},
+			},
+		},
+	})
+}
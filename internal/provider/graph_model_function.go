@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &GraphModelFunction{}
+
+func NewGraphModelFunction() function.Function {
+	return &GraphModelFunction{}
+}
+
+// GraphModelFunction defines the function to convert the adjacency map to the nodes and the relationships.
+type GraphModelFunction struct{}
+
+// graphModelNode describes the node of the graph model.
+type graphModelNode struct {
+	Key    string   `tfsdk:"key"`
+	Labels []string `tfsdk:"labels"`
+}
+
+// graphModelRelationship describes the relationship of the graph model.
+type graphModelRelationship struct {
+	Type  string `tfsdk:"type"`
+	Start string `tfsdk:"start"`
+	End   string `tfsdk:"end"`
+}
+
+// graphModel describes the nodes and the relationships keyed to be iterated over by for_each.
+type graphModel struct {
+	Nodes         map[string]graphModelNode         `tfsdk:"nodes"`
+	Relationships map[string]graphModelRelationship `tfsdk:"relationships"`
+}
+
+// parseNodeRef parses the reference to the node, i.e. its key prefixed by its labels, e.g. `Person:Employee:alice`.
+func parseNodeRef(ref string) (graphModelNode, error) {
+	parts := strings.Split(ref, ":")
+	key, labels := parts[len(parts)-1], parts[:len(parts)-1]
+	if key == "" || slices.Contains(labels, "") {
+		return graphModelNode{}, fmt.Errorf("invalid node reference %q, expected the key prefixed by "+
+			"the labels separated by colons, e.g. Person:alice", ref)
+	}
+	return graphModelNode{Key: key, Labels: labels}, nil
+}
+
+// newGraphModel converts the adjacency map to the graph model.
+// The adjacency map is keyed by the start nodes, and lists their end nodes by the relationship types.
+// The node may be referenced by its key alone if its labels are set elsewhere.
+func newGraphModel(adjacency map[string]map[string][]string) (graphModel, error) {
+	var o = graphModel{
+		Nodes:         map[string]graphModelNode{},
+		Relationships: map[string]graphModelRelationship{},
+	}
+
+	add := func(ref string) (string, error) {
+		node, err := parseNodeRef(ref)
+		if err != nil {
+			return "", err
+		}
+		current, ok := o.Nodes[node.Key]
+		switch {
+		case !ok || len(current.Labels) == 0:
+			if node.Labels == nil {
+				node.Labels = []string{}
+			}
+			o.Nodes[node.Key] = node
+		case len(node.Labels) > 0 && !slices.Equal(current.Labels, node.Labels):
+			return "", fmt.Errorf("the node %s has conflicting labels: %s and %s", node.Key,
+				strings.Join(current.Labels, ":"), strings.Join(node.Labels, ":"))
+		}
+		return node.Key, nil
+	}
+
+	for startRef, relationships := range adjacency {
+		start, err := add(startRef)
+		if err != nil {
+			return graphModel{}, err
+		}
+		for relationshipType, endRefs := range relationships {
+			if relationshipType == "" {
+				return graphModel{}, fmt.Errorf("the relationship type of the node %s is empty", start)
+			}
+			for _, endRef := range endRefs {
+				end, err := add(endRef)
+				if err != nil {
+					return graphModel{}, err
+				}
+				o.Relationships[start+"-"+relationshipType+"->"+end] = graphModelRelationship{
+					Type:  relationshipType,
+					Start: start,
+					End:   end,
+				}
+			}
+		}
+	}
+	return o, nil
+}
+
+func (f *GraphModelFunction) Metadata(_ context.Context, _ function.MetadataRequest,
+	resp *function.MetadataResponse) {
+	resp.Name = "graph_model"
+}
+
+func (f *GraphModelFunction) Definition(_ context.Context, _ function.DefinitionRequest,
+	resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts the adjacency map to the nodes and the relationships.",
+		MarkdownDescription: "Converts the compact graph description to the nodes and the relationships " +
+			"keyed to be created with `for_each` by the resources `neo4j_node` and `neo4j_relationship`." +
+			"\n\nThe adjacency map is keyed by the start nodes, and lists their end nodes by the relationship types. " +
+			"The node is referenced by its key prefixed by its labels separated by colons, e.g. `Person:alice`, " +
+			"or by its key alone if its labels are set elsewhere in the map." +
+			"\n\nThe function returns the object with the `nodes` keyed by their keys, " +
+			"and the `relationships` keyed by `<start>-<type>-><end>`.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "adjacency",
+				MarkdownDescription: "The end nodes of the relationships keyed by the start nodes and the relationship types.",
+				ElementType: types.MapType{
+					ElemType: types.ListType{ElemType: types.StringType},
+				},
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"nodes": types.MapType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+					"key":    types.StringType,
+					"labels": types.ListType{ElemType: types.StringType},
+				}}},
+				"relationships": types.MapType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+					"type":  types.StringType,
+					"start": types.StringType,
+					"end":   types.StringType,
+				}}},
+			},
+		},
+	}
+}
+
+func (f *GraphModelFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var adjacency map[string]map[string][]string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &adjacency))
+	if resp.Error != nil {
+		return
+	}
+
+	model, err := newGraphModel(adjacency)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, model))
+}
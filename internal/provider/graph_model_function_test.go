@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGraphModel(t *testing.T) {
+	got, err := newGraphModel(map[string]map[string][]string{
+		"Person:alice": {"KNOWS": {"bob"}, "WORKS_AT": {"Company:acme"}},
+		"Person:bob":   {},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, graphModel{
+		Nodes: map[string]graphModelNode{
+			"alice": {Key: "alice", Labels: []string{"Person"}},
+			"bob":   {Key: "bob", Labels: []string{"Person"}},
+			"acme":  {Key: "acme", Labels: []string{"Company"}},
+		},
+		Relationships: map[string]graphModelRelationship{
+			"alice-KNOWS->bob":     {Type: "KNOWS", Start: "alice", End: "bob"},
+			"alice-WORKS_AT->acme": {Type: "WORKS_AT", Start: "alice", End: "acme"},
+		},
+	}, got)
+
+	_, err = newGraphModel(map[string]map[string][]string{
+		"Person:alice": {"KNOWS": {"Robot:alice"}},
+	})
+	assert.ErrorContains(t, err, "conflicting labels")
+
+	_, err = newGraphModel(map[string]map[string][]string{"Person:": {}})
+	assert.ErrorContains(t, err, "invalid node reference")
+}
@@ -0,0 +1,417 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GraphSnapshotDataSource{}
+
+func NewGraphSnapshotDataSource() datasource.DataSource {
+	return &GraphSnapshotDataSource{}
+}
+
+// GraphSnapshotDataSource defines the data source to capture the snapshot of the subgraph,
+// and to compare it with the previous snapshot.
+type GraphSnapshotDataSource struct {
+	client *Client
+}
+
+// GraphSnapshotDataSourceModel describes the data source data model.
+type GraphSnapshotDataSourceModel struct {
+	Labels               types.List   `tfsdk:"labels"`
+	RelationshipTypes    types.List   `tfsdk:"relationship_types"`
+	Properties           types.List   `tfsdk:"properties"`
+	Previous             types.String `tfsdk:"previous"`
+	Snapshot             types.String `tfsdk:"snapshot"`
+	Arrows               types.String `tfsdk:"arrows"`
+	HasChanges           types.Bool   `tfsdk:"has_changes"`
+	NodesAdded           types.List   `tfsdk:"nodes_added"`
+	NodesRemoved         types.List   `tfsdk:"nodes_removed"`
+	NodesChanged         types.List   `tfsdk:"nodes_changed"`
+	RelationshipsAdded   types.List   `tfsdk:"relationships_added"`
+	RelationshipsRemoved types.List   `tfsdk:"relationships_removed"`
+	RelationshipsChanged types.List   `tfsdk:"relationships_changed"`
+}
+
+const graphSnapshotSuffix = "_graph_snapshot"
+
+// snapshotNode is the normalized node of the snapshot.
+type snapshotNode struct {
+	Key        string            `json:"key"`
+	Labels     []string          `json:"labels"`
+	Properties map[string]string `json:"properties"`
+}
+
+// snapshotRelationship is the normalized relationship of the snapshot, its ends are referenced by the nodes' keys.
+type snapshotRelationship struct {
+	Key        string            `json:"key"`
+	Type       string            `json:"type"`
+	Start      string            `json:"start"`
+	End        string            `json:"end"`
+	Properties map[string]string `json:"properties"`
+}
+
+// graphSnapshot is the normalized snapshot of the subgraph, the entities are sorted by their keys.
+type graphSnapshot struct {
+	Nodes         []snapshotNode         `json:"nodes"`
+	Relationships []snapshotRelationship `json:"relationships"`
+}
+
+// snapshotDiff lists the keys of the entities which differ between the snapshots.
+type snapshotDiff struct {
+	nodesAdded, nodesRemoved, nodesChanged                         []string
+	relationshipsAdded, relationshipsRemoved, relationshipsChanged []string
+}
+
+// isEmpty checks if the snapshots are the same.
+func (d snapshotDiff) isEmpty() bool {
+	for _, v := range [][]string{d.nodesAdded, d.nodesRemoved, d.nodesChanged,
+		d.relationshipsAdded, d.relationshipsRemoved, d.relationshipsChanged} {
+		if len(v) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *GraphSnapshotDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + graphSnapshotSuffix
+}
+
+func (d *GraphSnapshotDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+	diffAttribute := func(description string) schema.Attribute {
+		return schema.ListAttribute{
+			MarkdownDescription: description + " Not set unless `previous` is set.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Captures the normalized snapshot of the subgraph and compares it with the previous one, " +
+			"e.g. to review the changes of the reference data graph." +
+			"\n\n-> **Note** The entities are identified by their `uuid` property used by the provider " +
+			"to identify the resources. The entities without it are identified by their content, " +
+			"hence their changes are reported as the removal of the old entity and the addition of the new one.",
+		Attributes: map[string]schema.Attribute{
+			"labels": schema.ListAttribute{
+				MarkdownDescription: "The labels of the nodes to include. All nodes are included if not set.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"relationship_types": schema.ListAttribute{
+				MarkdownDescription: "The types of the relationships between the included nodes to include. " +
+					"All relationships are included if not set.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"properties": schema.ListAttribute{
+				MarkdownDescription: "The properties to include. All properties are included if not set.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"previous": schema.StringAttribute{
+				MarkdownDescription: "The previous `snapshot` to compare with, " +
+					"e.g. read from the file using the function `file`, or from the state of other configuration.",
+				Optional: true,
+			},
+			"snapshot": schema.StringAttribute{
+				MarkdownDescription: "The JSON snapshot of the subgraph, i.e. the object with the sorted lists " +
+					"`nodes` and `relationships`. The properties' values are formatted as strings.",
+				Computed: true,
+			},
+			"arrows": schema.StringAttribute{
+				MarkdownDescription: "The subgraph as the Arrows.app JSON document, e.g. to review it as the diagram, " +
+					"details: https://arrows.app. The nodes are placed on the grid.",
+				Computed: true,
+			},
+			"has_changes": schema.BoolAttribute{
+				MarkdownDescription: "True if the subgraph differs from the `previous` snapshot. " +
+					"Not set unless `previous` is set.",
+				Computed: true,
+			},
+			"nodes_added":           diffAttribute("The keys of the nodes added since the `previous` snapshot."),
+			"nodes_removed":         diffAttribute("The keys of the nodes removed since the `previous` snapshot."),
+			"nodes_changed":         diffAttribute("The keys of the nodes which labels, or properties changed."),
+			"relationships_added":   diffAttribute("The keys of the relationships added since the `previous` snapshot."),
+			"relationships_removed": diffAttribute("The keys of the relationships removed since the `previous` snapshot."),
+			"relationships_changed": diffAttribute("The keys of the relationships which ends, type, or properties changed."),
+		},
+	}
+}
+
+func (d *GraphSnapshotDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// snapshotProperties formats the selected properties, the identity property is excluded.
+func snapshotProperties(properties map[string]any, selected []string) map[string]string {
+	var o = map[string]string{}
+	for k, v := range selectProperties(properties, selected) {
+		o[k] = formatProperty(v)
+	}
+	return o
+}
+
+// snapshotKey identifies the entity by its uuid, or by the digest of its content if the uuid is not set.
+func snapshotKey(properties map[string]any, content map[string]any) (string, error) {
+	if id, ok := properties["uuid"].(string); ok && id != "" {
+		return id, nil
+	}
+	return entityDigest(content)
+}
+
+// readSnapshot reads the normalized snapshot of the subgraph.
+func readSnapshot(ctx context.Context, tx neo4j.ManagedTransaction, labels, relationshipTypes,
+	properties []string) (o graphSnapshot, err error) {
+	dbResp, err := tx.Run(ctx, graphChecksumNodesQuery, map[string]any{"labels": labels})
+	if err != nil {
+		return o, err
+	}
+	var nodeKeys = map[string]string{}
+	var rec *neo4j.Record
+	for dbResp.NextRecord(ctx, &rec) {
+		id, _ := rec.Values[0].(string)
+		var node = snapshotNode{Labels: []string{}}
+		nodeLabels, _ := rec.Values[1].([]any)
+		for _, l := range nodeLabels {
+			node.Labels = append(node.Labels, fmt.Sprint(l))
+		}
+		slices.Sort(node.Labels)
+		props, _ := rec.Values[2].(map[string]any)
+		node.Properties = snapshotProperties(props, properties)
+		if node.Key, err = snapshotKey(props, map[string]any{
+			"labels":     node.Labels,
+			"properties": node.Properties,
+		}); err != nil {
+			return o, err
+		}
+		nodeKeys[id] = node.Key
+		o.Nodes = append(o.Nodes, node)
+	}
+	if err := dbResp.Err(); err != nil {
+		return o, err
+	}
+
+	dbResp, err = tx.Run(ctx, graphChecksumRelationshipsQuery, map[string]any{
+		"labels": labels,
+		"types":  relationshipTypes,
+	})
+	if err != nil {
+		return o, err
+	}
+	for dbResp.NextRecord(ctx, &rec) {
+		start, _ := rec.Values[0].(string)
+		end, _ := rec.Values[1].(string)
+		relationshipType, _ := rec.Values[2].(string)
+		props, _ := rec.Values[3].(map[string]any)
+		var relationship = snapshotRelationship{
+			Type:       relationshipType,
+			Start:      nodeKeys[start],
+			End:        nodeKeys[end],
+			Properties: snapshotProperties(props, properties),
+		}
+		if relationship.Key, err = snapshotKey(props, map[string]any{
+			"type":       relationship.Type,
+			"start":      relationship.Start,
+			"end":        relationship.End,
+			"properties": relationship.Properties,
+		}); err != nil {
+			return o, err
+		}
+		o.Relationships = append(o.Relationships, relationship)
+	}
+	if err := dbResp.Err(); err != nil {
+		return o, err
+	}
+
+	o.sort()
+	return o, nil
+}
+
+// sort orders the entities by their keys to make the snapshot independent of the order they are read in.
+func (s *graphSnapshot) sort() {
+	if s.Nodes == nil {
+		s.Nodes = []snapshotNode{}
+	}
+	if s.Relationships == nil {
+		s.Relationships = []snapshotRelationship{}
+	}
+	slices.SortFunc(s.Nodes, func(a, b snapshotNode) int { return strings.Compare(a.Key, b.Key) })
+	slices.SortFunc(s.Relationships, func(a, b snapshotRelationship) int { return strings.Compare(a.Key, b.Key) })
+}
+
+// diffEntities compares the entities indexed by their keys.
+func diffEntities[T any](previous, current map[string]T) (added, removed, changed []string) {
+	for k, v := range current {
+		prev, ok := previous[k]
+		switch {
+		case !ok:
+			added = append(added, k)
+		case !reflect.DeepEqual(prev, v):
+			changed = append(changed, k)
+		}
+	}
+	for k := range previous {
+		if _, ok := current[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+	slices.Sort(added)
+	slices.Sort(removed)
+	slices.Sort(changed)
+	return added, removed, changed
+}
+
+// diffSnapshots compares the snapshots.
+func diffSnapshots(previous, current graphSnapshot) (o snapshotDiff) {
+	index := func(s graphSnapshot) (map[string]snapshotNode, map[string]snapshotRelationship) {
+		var nodes, relationships = map[string]snapshotNode{}, map[string]snapshotRelationship{}
+		for _, v := range s.Nodes {
+			nodes[v.Key] = v
+		}
+		for _, v := range s.Relationships {
+			relationships[v.Key] = v
+		}
+		return nodes, relationships
+	}
+	previousNodes, previousRelationships := index(previous)
+	currentNodes, currentRelationships := index(current)
+	o.nodesAdded, o.nodesRemoved, o.nodesChanged = diffEntities(previousNodes, currentNodes)
+	o.relationshipsAdded, o.relationshipsRemoved, o.relationshipsChanged = diffEntities(
+		previousRelationships, currentRelationships)
+	return o
+}
+
+func (d *GraphSnapshotDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+	var data GraphSnapshotDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The lists are passed to the queries as empty lists if not set.
+	var labels, relationshipTypes, properties = make([]string, 0), make([]string, 0), make([]string, 0)
+	for _, v := range []struct {
+		list   types.List
+		target *[]string
+	}{
+		{data.Labels, &labels},
+		{data.RelationshipTypes, &relationshipTypes},
+		{data.Properties, &properties},
+	} {
+		if !v.list.IsNull() {
+			resp.Diagnostics.Append(v.list.ElementsAs(ctx, v.target, false)...)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var previous *graphSnapshot
+	if !data.Previous.IsNull() {
+		previous = &graphSnapshot{}
+		if err := json.Unmarshal([]byte(data.Previous.ValueString()), previous); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("previous"), "invalid previous snapshot", err.Error())
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "capturing the graph snapshot")
+
+	var snapshot graphSnapshot
+	// The nodes and the relationships are read in the same transaction to refer to the nodes by their internal ids.
+	if _, err := d.client.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		var err error
+		snapshot, err = readSnapshot(ctx, tx, labels, relationshipTypes, properties)
+		return nil, err
+	}); err != nil {
+		tflog.Debug(ctx, "failed to read the graph")
+		resp.Diagnostics.AddError("failed to capture the graph snapshot", err.Error())
+		return
+	}
+
+	o, err := json.Marshal(snapshot)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to encode the graph snapshot", err.Error())
+		return
+	}
+	data.Snapshot = types.StringValue(string(o))
+
+	if o, err = json.Marshal(toArrows(snapshot)); err != nil {
+		resp.Diagnostics.AddError("failed to encode the arrows document", err.Error())
+		return
+	}
+	data.Arrows = types.StringValue(string(o))
+
+	data.HasChanges = types.BoolNull()
+	for _, v := range []*types.List{&data.NodesAdded, &data.NodesRemoved, &data.NodesChanged,
+		&data.RelationshipsAdded, &data.RelationshipsRemoved, &data.RelationshipsChanged} {
+		*v = types.ListNull(types.StringType)
+	}
+	if previous != nil {
+		diff := diffSnapshots(*previous, snapshot)
+		data.HasChanges = types.BoolValue(!diff.isEmpty())
+		for _, v := range []struct {
+			keys   []string
+			target *types.List
+		}{
+			{diff.nodesAdded, &data.NodesAdded},
+			{diff.nodesRemoved, &data.NodesRemoved},
+			{diff.nodesChanged, &data.NodesChanged},
+			{diff.relationshipsAdded, &data.RelationshipsAdded},
+			{diff.relationshipsRemoved, &data.RelationshipsRemoved},
+			{diff.relationshipsChanged, &data.RelationshipsChanged},
+		} {
+			if v.keys == nil {
+				v.keys = []string{}
+			}
+			list, diags := types.ListValueFrom(ctx, types.StringType, v.keys)
+			resp.Diagnostics.Append(diags...)
+			*v.target = list
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "captured the graph snapshot", map[string]interface{}{
+		"nodes":         len(snapshot.Nodes),
+		"relationships": len(snapshot.Relationships),
+	})
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffSnapshots(t *testing.T) {
+	previous := graphSnapshot{
+		Nodes: []snapshotNode{
+			{Key: "a", Labels: []string{"Country"}, Properties: map[string]string{"name": "Germany"}},
+			{Key: "b", Labels: []string{"Country"}, Properties: map[string]string{"name": "France"}},
+		},
+		Relationships: []snapshotRelationship{
+			{Key: "ab", Type: "BORDERS", Start: "a", End: "b", Properties: map[string]string{}},
+		},
+	}
+	current := graphSnapshot{
+		Nodes: []snapshotNode{
+			{Key: "a", Labels: []string{"Country"}, Properties: map[string]string{"name": "Deutschland"}},
+			{Key: "c", Labels: []string{"Country"}, Properties: map[string]string{"name": "Austria"}},
+		},
+		Relationships: []snapshotRelationship{
+			{Key: "ac", Type: "BORDERS", Start: "a", End: "c", Properties: map[string]string{}},
+		},
+	}
+
+	diff := diffSnapshots(previous, current)
+	assert.Equal(t, []string{"c"}, diff.nodesAdded)
+	assert.Equal(t, []string{"b"}, diff.nodesRemoved)
+	assert.Equal(t, []string{"a"}, diff.nodesChanged)
+	assert.Equal(t, []string{"ac"}, diff.relationshipsAdded)
+	assert.Equal(t, []string{"ab"}, diff.relationshipsRemoved)
+	assert.Empty(t, diff.relationshipsChanged)
+	assert.False(t, diff.isEmpty())
+
+	assert.True(t, diffSnapshots(current, current).isEmpty())
+}
+
+func TestAccGraphSnapshotDataSource(t *testing.T) {
+	t.Setenv("DB_URI", testDbURI)
+	t.Setenv("DB_USER", testDBUser)
+	t.Cleanup(func() {
+		t.Setenv("DB_URI", "")
+		t.Setenv("DB_USER", "")
+	})
+
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err != nil {
+		t.Errorf("could not conenct to database: %v\n", err)
+		return
+	}
+	t.Cleanup(func() { _ = c.Close(ctx) })
+
+	if _, err := c.Run(ctx, `CREATE (a:Snapshot{uuid:"snapshot-a", name:"a"}),
+(b:Snapshot{uuid:"snapshot-b", name:"b"}), (a)-[:SNAPSHOT_LINK{uuid:"snapshot-ab"}]->(b)`, nil); err != nil {
+		t.Fatalf("could not seed the graph: %v", err)
+	}
+	t.Cleanup(func() {
+		_, _ = c.Run(ctx, `MATCH (n:Snapshot) DETACH DELETE n`, nil)
+	})
+
+	const dataSourceAddress = "data." + Name + graphSnapshotSuffix + ".current"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "neo4j_graph_snapshot" "current" {
+labels   = ["Snapshot"]
+previous = jsonencode({
+  nodes = [
+    { key = "snapshot-a", labels = ["Snapshot"], properties = { name = "a" } },
+    { key = "snapshot-c", labels = ["Snapshot"], properties = { name = "c" } },
+  ]
+  relationships = []
+})
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("has_changes"),
+						knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("arrows"),
+						knownvalue.StringRegexp(regexp.MustCompile(`"fromId":"n0","toId":"n1","type":"SNAPSHOT_LINK"`))),
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("nodes_added"),
+						knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("snapshot-b")})),
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("nodes_removed"),
+						knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("snapshot-c")})),
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("nodes_changed"),
+						knownvalue.ListSizeExact(0)),
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("relationships_added"),
+						knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("snapshot-ab")})),
+				},
+			},
+			{
+				Config: `data "neo4j_graph_snapshot" "previous" {
+labels = ["Snapshot"]
+}
+
+data "neo4j_graph_snapshot" "current" {
+labels   = ["Snapshot"]
+previous = data.neo4j_graph_snapshot.previous.snapshot
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("has_changes"),
+						knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("data."+Name+graphSnapshotSuffix+".previous",
+						tfjsonpath.New("has_changes"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
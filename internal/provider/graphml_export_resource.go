@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GraphMLExportResource{}
+var _ resource.ResourceWithModifyPlan = &GraphMLExportResource{}
+
+func NewGraphMLExportResource() resource.Resource {
+	return &GraphMLExportResource{}
+}
+
+// GraphMLExportResource defines the resource to export the graph to GraphML.
+type GraphMLExportResource struct {
+	client *Client
+}
+
+// GraphMLExportResourceModel describes the resource data model.
+type GraphMLExportResourceModel struct {
+	File              types.String `tfsdk:"file"`
+	Query             types.String `tfsdk:"query"`
+	ExportOnDestroy   types.Bool   `tfsdk:"export_on_destroy"`
+	ValidateOnPlan    types.Bool   `tfsdk:"validate_on_plan"`
+	Triggers          types.Map    `tfsdk:"triggers"`
+	NodeCount         types.Int64  `tfsdk:"node_count"`
+	RelationshipCount types.Int64  `tfsdk:"relationship_count"`
+}
+
+const graphMLExportSuffix = "_graphml_export"
+
+func (r *GraphMLExportResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + graphMLExportSuffix
+}
+
+func (r *GraphMLExportResource) Schema(_ context.Context, _ resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Exports the graph, or its subgraph, to GraphML using APOC, details: " +
+			"https://neo4j.com/docs/apoc/current/export/graphml/" +
+			"\n\n-> **Note** The export runs when the resource is created, or replaced because " +
+			"any of the `triggers` changed. The exported file is kept when the resource is destroyed." +
+			"\n\n!>**Warning** The resource requires the APOC plugin with `apoc.export.file.enabled=true`.",
+		Attributes: map[string]schema.Attribute{
+			"file": schema.StringAttribute{
+				MarkdownDescription: "The path, or URL, of the file to export the graph to.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Cypher query to select the subgraph to export. " +
+					"The whole graph is exported if not set.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"export_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Set to export the graph again when the resource is destroyed, " +
+					"e.g. to backup the graph before it's destroyed by Terraform.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"validate_on_plan": schema.BoolAttribute{
+				MarkdownDescription: "Set to validate the query with `EXPLAIN` when the plan is made. " +
+					"Disable it when the database is not reachable at plan time.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values which trigger the export when changed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers:       []planmodifier.Map{mapplanmodifier.RequiresReplace()},
+			},
+			"node_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of exported nodes.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"relationship_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of exported relationships.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *GraphMLExportResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GraphMLExportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan GraphMLExportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !plan.ValidateOnPlan.ValueBool() ||
+		plan.Query.IsNull() || plan.Query.IsUnknown() {
+		return
+	}
+
+	if err := r.client.Explain(ctx, plan.Query.ValueString(), nil); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("query"), "invalid query", err.Error())
+	}
+}
+
+func (r *GraphMLExportResource) export(ctx context.Context, data *GraphMLExportResourceModel) error {
+	query := `CALL apoc.export.graphml.all($file, {useTypes: true})
+YIELD nodes, relationships
+RETURN nodes, relationships`
+	if !data.Query.IsNull() {
+		query = `CALL apoc.export.graphml.query($query, $file, {useTypes: true})
+YIELD nodes, relationships
+RETURN nodes, relationships`
+	}
+
+	dbResp, err := r.client.Run(ctx, query, map[string]any{
+		"file":  data.File.ValueString(),
+		"query": data.Query.ValueString(),
+	})
+	if err != nil {
+		return err
+	}
+	rec, err := dbResp.Single(ctx)
+	if err != nil {
+		return err
+	}
+	m := rec.AsMap()
+	nodeCount, okNodes := m["nodes"].(int64)
+	relationshipCount, okRelationships := m["relationships"].(int64)
+	if !okNodes || !okRelationships {
+		return fmt.Errorf("unexpected export result: %v", m)
+	}
+	data.NodeCount = types.Int64Value(nodeCount)
+	data.RelationshipCount = types.Int64Value(relationshipCount)
+	return nil
+}
+
+func (r *GraphMLExportResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+	var data GraphMLExportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+graphMLExportSuffix, auditOperationCreate, &data.File)
+
+	props := map[string]interface{}{"file": data.File.ValueString()}
+	tflog.Trace(ctx, "export the graph to graphml", props)
+	if err := r.export(ctx, &data); err != nil {
+		tflog.Debug(ctx, "failed to export the graph to graphml", props)
+		resp.Diagnostics.AddError("failed to export the graph to graphml", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "exported the graph to graphml", props)
+}
+
+func (r *GraphMLExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The export is a one-off action, hence there is nothing to read back.
+	var data GraphMLExportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphMLExportResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+	var data GraphMLExportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphMLExportResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+	var data GraphMLExportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ExportOnDestroy.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+graphMLExportSuffix, auditOperationDelete, &data.File)
+
+	props := map[string]interface{}{"file": data.File.ValueString()}
+	tflog.Trace(ctx, "export the graph to graphml before destroy", props)
+	if err := r.export(ctx, &data); err != nil {
+		tflog.Debug(ctx, "failed to export the graph to graphml", props)
+		resp.Diagnostics.AddError("failed to export the graph to graphml", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "exported the graph to graphml before destroy", props)
+}
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccGraphMLExportResource(t *testing.T) {
+	t.Setenv("DB_URI", testDbURI)
+	t.Setenv("DB_USER", testDBUser)
+	t.Cleanup(func() {
+		t.Setenv("DB_URI", "")
+		t.Setenv("DB_USER", "")
+	})
+
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err != nil {
+		t.Errorf("could not conenct to database: %v\n", err)
+		return
+	}
+	t.Cleanup(func() { _ = c.Close(ctx) })
+
+	if _, err = c.Run(ctx, `CREATE (:GraphMLExport{uuid:"graphml-0"})-[:LINKS]->(:GraphMLExport{uuid:"graphml-1"})`,
+		nil); err != nil {
+		t.Errorf("could not seed the graph: %v\n", err)
+		return
+	}
+	t.Cleanup(func() {
+		_, _ = c.Run(ctx, `MATCH (n:GraphMLExport) DETACH DELETE n`, nil)
+	})
+
+	// countNodes returns the number of the nodes in the database, and in the exported file.
+	countNodes := func(file string) (inDB, inFile int64, err error) {
+		r, err := c.Run(ctx, `MATCH (n) RETURN count(n)`, nil)
+		if err != nil {
+			return 0, 0, err
+		}
+		rec, err := r.Single(ctx)
+		if err != nil {
+			return 0, 0, err
+		}
+		inDB = rec.Values[0].(int64)
+
+		r, err = c.Run(ctx, `CALL apoc.load.xml($file) YIELD value
+UNWIND [el IN value._children WHERE el._type = "graph"] AS graph
+RETURN size([el IN graph._children WHERE el._type = "node"])`, map[string]any{"file": "file:///" + file})
+		if err != nil {
+			return 0, 0, err
+		}
+		if rec, err = r.Single(ctx); err != nil {
+			return 0, 0, err
+		}
+		inFile = rec.Values[0].(int64)
+		return inDB, inFile, nil
+	}
+
+	const resourceAddress = Name + graphMLExportSuffix + "._"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the faulty query is rejected when the plan is made
+			{
+				Config: `resource "neo4j_graphml_export" "_" {
+file  = "export.graphml"
+query = "MATCH p = (:GraphMLExport)-[:LINKS]->(:GraphMLExport RETURN p"
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("invalid query"),
+			},
+			{
+				Config: `resource "neo4j_graphml_export" "_" {
+file  = "export.graphml"
+query = "MATCH p = (:GraphMLExport)-[:LINKS]->(:GraphMLExport) RETURN p"
+triggers = {
+  foo = "bar"
+}
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceAddress, tfjsonpath.New("node_count"),
+						knownvalue.Int64Exact(2)),
+					statecheck.ExpectKnownValue(resourceAddress, tfjsonpath.New("relationship_count"),
+						knownvalue.Int64Exact(1)),
+					statecheck.ExpectKnownValue(resourceAddress, tfjsonpath.New("export_on_destroy"),
+						knownvalue.Bool(false)),
+				},
+			},
+			// changed triggers export the graph again
+			{
+				PreConfig: func() {
+					if _, err := c.Run(ctx,
+						`CREATE (:GraphMLExport{uuid:"graphml-2"})-[:LINKS]->(:GraphMLExport{uuid:"graphml-3"})`,
+						nil); err != nil {
+						t.Errorf("could not extend the graph: %v\n", err)
+					}
+				},
+				Config: `resource "neo4j_graphml_export" "_" {
+file  = "export.graphml"
+query = "MATCH p = (:GraphMLExport)-[:LINKS]->(:GraphMLExport) RETURN p"
+triggers = {
+  foo = "baz"
+}
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceAddress, plancheck.ResourceActionReplace),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceAddress, tfjsonpath.New("node_count"),
+						knownvalue.Int64Exact(4)),
+					statecheck.ExpectKnownValue(resourceAddress, tfjsonpath.New("relationship_count"),
+						knownvalue.Int64Exact(2)),
+				},
+			},
+			// the whole graph is exported when no query is set
+			{
+				Config: `resource "neo4j_graphml_export" "_" {
+file              = "export-all.graphml"
+export_on_destroy = true
+}`,
+				Check: resource.TestCheckResourceAttrWith(resourceAddress, "node_count", func(v string) error {
+					inDB, inFile, err := countNodes("export-all.graphml")
+					if err != nil {
+						return err
+					}
+					if v != strconv.FormatInt(inDB, 10) || inFile != inDB {
+						return fmt.Errorf("expected %d exported nodes, got %s in state and %d in file",
+							inDB, v, inFile)
+					}
+					return nil
+				}),
+			},
+			// no export without the changed triggers
+			{
+				PreConfig: func() {
+					if _, err := c.Run(ctx, `CREATE (:GraphMLExport{uuid:"graphml-4"})`, nil); err != nil {
+						t.Errorf("could not extend the graph: %v\n", err)
+					}
+				},
+				Config: `resource "neo4j_graphml_export" "_" {
+file              = "export-all.graphml"
+export_on_destroy = true
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+		// the graph shall be exported again on destroy, hence the file includes the last created node
+		CheckDestroy: func(_ *terraform.State) error {
+			inDB, inFile, err := countNodes("export-all.graphml")
+			if err != nil {
+				return err
+			}
+			assert.Equal(t, inDB, inFile, "the graph shall be exported on destroy")
+			return nil
+		},
+	})
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// executeAsRoleAttribute defines the attribute to apply the resource's changes with the role's privileges.
+var executeAsRoleAttribute = schema.StringAttribute{
+	MarkdownDescription: "The role to apply the changes with, i.e. the changes are run on behalf of " +
+		"the user assigned to the role in the provider's `role_users`, e.g. to apply the data changes " +
+		"with the least privilege. The provider's user is used if not set." +
+		"\n\n-> **Note** The impersonation is only supported in the Neo4j Enterprise Edition. " +
+		"The provider's user must be granted the `IMPERSONATE` privilege.",
+	Optional: true,
+}
+
+// impersonatedClients keeps the clients which run the queries on behalf of the impersonated users.
+type impersonatedClients struct {
+	mu      sync.Mutex
+	clients map[string]*Client
+}
+
+// asRole returns the client which runs the queries on behalf of the user assigned to the role.
+// The client itself is returned if the role is not set.
+func (c *Client) asRole(ctx context.Context, role types.String) (*Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if role.IsNull() || role.ValueString() == "" {
+		return c, diags
+	}
+
+	user, ok := c.roleUsers[role.ValueString()]
+	if !ok {
+		diags.AddAttributeError(path.Root("execute_as_role"), "unknown role",
+			fmt.Sprintf("no user is assigned to the role %s in the provider's role_users", role.ValueString()))
+		return nil, diags
+	}
+
+	c.impersonated.mu.Lock()
+	defer c.impersonated.mu.Unlock()
+	if v, ok := c.impersonated.clients[user]; ok {
+		return v, diags
+	}
+
+	var impersonated = *c
+	impersonated.impersonatedUser = user
+	impersonated.SessionWithContext = c.driver.NewSession(ctx, neo4j.SessionConfig{
+		DatabaseName:     c.database,
+		ImpersonatedUser: user,
+		BookmarkManager:  c.bookmarks,
+	})
+	if c.impersonated.clients == nil {
+		c.impersonated.clients = map[string]*Client{}
+	}
+	c.impersonated.clients[user] = &impersonated
+	return &impersonated, diags
+}
+
+// closeImpersonated closes the sessions of the impersonated users.
+func (c *Client) closeImpersonated(ctx context.Context) error {
+	if c.impersonated == nil || c.impersonatedUser != "" {
+		return nil
+	}
+	c.impersonated.mu.Lock()
+	defer c.impersonated.mu.Unlock()
+	var errs []error
+	for _, v := range c.impersonated.clients {
+		errs = append(errs, v.SessionWithContext.Close(ctx))
+	}
+	c.impersonated.clients = nil
+	return errors.Join(errs...)
+}
+
+// executeQueryConfigurers defines the settings of the queries run outside the client's session.
+func (c *Client) executeQueryConfigurers(database string) []neo4j.ExecuteQueryConfigurationOption {
+	var o = []neo4j.ExecuteQueryConfigurationOption{neo4j.ExecuteQueryWithDatabase(database)}
+	if c.impersonatedUser != "" {
+		o = append(o, neo4j.ExecuteQueryWithImpersonatedUser(c.impersonatedUser))
+	}
+	return o
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientAsRole(t *testing.T) {
+	ctx := context.Background()
+	driver, err := neo4j.NewDriverWithContext("bolt://localhost:1", neo4j.NoAuth())
+	if !assert.NoError(t, err) {
+		return
+	}
+	c := &Client{
+		SessionWithContext: driver.NewSession(ctx, neo4j.SessionConfig{}),
+		driver:             driver,
+		database:           "neo4j",
+		roleUsers:          map[string]string{"editor": "tf_editor"},
+		impersonated:       &impersonatedClients{},
+	}
+	t.Cleanup(func() { _ = c.Close(ctx) })
+
+	got, diags := c.asRole(ctx, types.StringNull())
+	assert.False(t, diags.HasError())
+	assert.Same(t, c, got)
+
+	_, diags = c.asRole(ctx, types.StringValue("admin"))
+	assert.True(t, diags.HasError())
+
+	got, diags = c.asRole(ctx, types.StringValue("editor"))
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "tf_editor", got.impersonatedUser)
+	assert.Equal(t, "", c.impersonatedUser)
+
+	again, _ := c.asRole(ctx, types.StringValue("editor"))
+	assert.Same(t, got, again, "the impersonated client shall be reused")
+}
@@ -0,0 +1,394 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IndexResource{}
+var _ resource.ResourceWithImportState = &IndexResource{}
+
+func NewIndexResource() resource.Resource {
+	return &IndexResource{}
+}
+
+// IndexResource defines the property index resource implementation.
+type IndexResource struct {
+	client *Client
+}
+
+// IndexResourceModel describes the resource data model.
+type IndexResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	IndexType   types.String `tfsdk:"index_type"`
+	EntityType  types.String `tfsdk:"entity_type"`
+	LabelOrType types.String `tfsdk:"label_or_type"`
+	Properties  types.List   `tfsdk:"properties"`
+
+	WaitForOnline types.Bool   `tfsdk:"wait_for_online"`
+	OnlineTimeout types.String `tfsdk:"online_timeout"`
+}
+
+const (
+	indexSuffix = "_index"
+
+	indexTypeRange = "RANGE"
+	indexTypeText  = "TEXT"
+	indexTypePoint = "POINT"
+
+	indexStateOnline = "ONLINE"
+	indexStateFailed = "FAILED"
+
+	indexOnlineDefaultTimeout = 5 * time.Minute
+	indexOnlinePollInterval   = time.Second
+)
+
+// waitForOnlineAttribute defines the attribute to wait until the created index is populated.
+var waitForOnlineAttribute = schema.BoolAttribute{
+	MarkdownDescription: "Set to wait until the created index is populated and online, " +
+		"e.g. for the queries of the dependent resources to use it.",
+	Optional: true,
+}
+
+// onlineTimeoutAttribute defines the maximum time to wait until the created index is online.
+var onlineTimeoutAttribute = schema.StringAttribute{
+	MarkdownDescription: "The maximum time to wait until the created index is online if `wait_for_online` is set, " +
+		"e.g. `30m` for the large graph. Defaults to `5m`.",
+	Optional:   true,
+	Validators: durationValidators,
+}
+
+func (r *IndexResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + indexSuffix
+}
+
+func (r *IndexResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Neo4j range, text, or point index on the nodes' or the relationships' properties, details: " +
+			"https://neo4j.com/docs/cypher-manual/current/indexes/search-performance-indexes/overview/" +
+			"\n\n-> **Note** The text and point indexes can only be created on a single property.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Index name.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"index_type": schema.StringAttribute{
+				MarkdownDescription: "Index type: `RANGE`, `TEXT`, or `POINT`. Defaults to `RANGE`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(indexTypeRange),
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.OneOf(indexTypeRange, indexTypeText, indexTypePoint),
+				},
+			},
+			"entity_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the indexed entities: `NODE` or `RELATIONSHIP`.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.OneOf(entityTypeNode, entityTypeRelationship),
+				},
+			},
+			"label_or_type": schema.StringAttribute{
+				MarkdownDescription: "The label of the indexed nodes, or the type of the indexed relationships.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"properties": schema.ListAttribute{
+				MarkdownDescription: "The indexed properties.",
+				Required:            true,
+				ElementType:         types.StringType,
+				PlanModifiers:       []planmodifier.List{listplanmodifier.RequiresReplace()},
+				Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+			},
+			"wait_for_online": waitForOnlineAttribute,
+			"online_timeout":  onlineTimeoutAttribute,
+		},
+	}
+}
+
+func (r *IndexResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// quoteName escapes the label, relationship type, or property name to be used in the Cypher query.
+func quoteName(s string) string {
+	return "`" + strings.ReplaceAll(s, "`", "``") + "`"
+}
+
+// createIndexQuery defines the query to create the index.
+// The labels, types and properties cannot be passed as parameters to the index commands,
+// hence they are escaped and embedded into the query.
+func createIndexQuery(indexType, entityType, labelOrType string, properties []string) string {
+	var pattern, variable = "(e:" + quoteName(labelOrType) + ")", "e"
+	if entityType == entityTypeRelationship {
+		pattern = "()-[e:" + quoteName(labelOrType) + "]-()"
+	}
+
+	var props = make([]string, len(properties))
+	for i, p := range properties {
+		props[i] = variable + "." + quoteName(p)
+	}
+
+	return "CREATE " + indexType + " INDEX $name FOR " + pattern + " ON (" + strings.Join(props, ", ") + ")"
+}
+
+// indexOnlineTimeout reads the maximum time to wait until the index is online.
+func indexOnlineTimeout(v types.String) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if v.IsNull() {
+		return indexOnlineDefaultTimeout, diags
+	}
+	timeout, err := time.ParseDuration(v.ValueString())
+	if err != nil || timeout <= 0 {
+		diags.AddAttributeError(path.Root("online_timeout"), "invalid timeout",
+			fmt.Sprintf("expected the positive duration, e.g. 30s, got: %s", v.ValueString()))
+	}
+	return timeout, diags
+}
+
+// indexState reads the state of the index, e.g. POPULATING, or ONLINE.
+func (c *Client) indexState(ctx context.Context, name string) (string, error) {
+	dbResp, err := c.Run(ctx, `SHOW INDEXES YIELD name, state WHERE name = $name RETURN state`,
+		map[string]any{"name": name})
+	if err != nil {
+		return "", err
+	}
+	records, err := dbResp.Collect(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(records) == 0 {
+		return "", fmt.Errorf("the index %s is not found", name)
+	}
+	return fmt.Sprintf("%v", records[0].Values[0]), nil
+}
+
+// waitIndexOnline polls the state of the index until it's populated and online.
+func (c *Client) waitIndexOnline(ctx context.Context, name string, timeout time.Duration) (diags diag.Diagnostics) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var state string
+	for {
+		v, err := c.indexState(ctx, name)
+		switch {
+		case err != nil && ctx.Err() == nil:
+			diags.AddError("failed to read the index state", err.Error())
+			return diags
+		case err == nil:
+			state = v
+		}
+		switch state {
+		case indexStateOnline:
+			return diags
+		case indexStateFailed:
+			diags.AddError("failed to populate the index",
+				fmt.Sprintf("the index %s failed, it shall be dropped and created again", name))
+			return diags
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.Canceled) {
+				diags.AddError("cancelled waiting for the index to be online", ctx.Err().Error())
+				return diags
+			}
+			diags.AddError("timed out waiting for the index to be online",
+				fmt.Sprintf("the index %s is %s, waited for %s", name, state, timeout))
+			return diags
+		case <-time.After(indexOnlinePollInterval):
+		}
+	}
+}
+
+func (r *IndexResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+	var data IndexResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+indexSuffix, auditOperationCreate, &data.Name)
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "create an index", props)
+
+	var properties []string
+	resp.Diagnostics.Append(data.Properties.ElementsAs(ctx, &properties, false)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Debug(ctx, "faulty properties provided", props)
+		return
+	}
+
+	timeout, diags := indexOnlineTimeout(data.OnlineTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := createIndexQuery(data.IndexType.ValueString(), data.EntityType.ValueString(),
+		data.LabelOrType.ValueString(), properties)
+	if _, err := r.client.Run(ctx, query, map[string]any{"name": data.Name.ValueString()}); err != nil {
+		tflog.Debug(ctx, "failed to create the index", props)
+		resp.Diagnostics.AddError("failed to create the index", err.Error())
+		return
+	}
+
+	// The index is populated in the background. The state is set even if it doesn't get online,
+	// hence the index is tainted instead of being left behind.
+	if data.WaitForOnline.ValueBool() {
+		tflog.Trace(ctx, "waiting for the index to be online", props)
+		if diags := r.client.waitIndexOnline(ctx, data.Name.ValueString(), timeout); diags.HasError() {
+			tflog.Debug(ctx, "failed to wait for the index to be online", props)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "created an index", props)
+}
+
+func (r *IndexResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IndexResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "reading the index", props)
+
+	dbResp, err := r.client.Run(ctx, `SHOW INDEXES YIELD name, type, entityType, labelsOrTypes, properties
+WHERE name = $name AND type IN ["RANGE", "TEXT", "POINT"]
+RETURN type, entityType, labelsOrTypes[0] AS labelOrType, properties`,
+		map[string]any{"name": data.Name.ValueString()})
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the index", props)
+		resp.Diagnostics.AddError("failed to read the index", err.Error())
+		return
+	}
+
+	var rec *neo4j.Record
+	if !dbResp.NextRecord(ctx, &rec) {
+		// The index was dropped outside of Terraform, hence it shall be recreated.
+		tflog.Debug(ctx, "no index found", props)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	m := rec.AsMap()
+	data.IndexType = toStringValue(m["type"])
+	data.EntityType = toStringValue(m["entityType"])
+	data.LabelOrType = toStringValue(m["labelOrType"])
+
+	var properties []string
+	if v, ok := m["properties"].([]any); ok {
+		for _, p := range v {
+			properties = append(properties, fmt.Sprintf("%v", p))
+		}
+	}
+	var diags diag.Diagnostics
+	data.Properties, diags = types.ListValueFrom(ctx, types.StringType, properties)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the index", props)
+}
+
+func (r *IndexResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+	// All attributes require replacement, hence the plan is only copied to the state.
+	var data IndexResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IndexResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+	var data IndexResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+indexSuffix, auditOperationDelete, &data.Name)
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "delete the index", props)
+	if _, err := r.client.Run(ctx, `DROP INDEX $name IF EXISTS`,
+		map[string]any{"name": data.Name.ValueString()}); err != nil {
+		tflog.Debug(ctx, "failed to delete the index", props)
+		resp.Diagnostics.AddError("failed to delete the index", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "deleted the index", props)
+}
+
+func (r *IndexResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+	name, err := r.client.importID(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("unexpected import identifier", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
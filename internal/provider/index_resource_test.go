@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateIndexQuery(t *testing.T) {
+	tests := []struct {
+		name        string
+		indexType   string
+		entityType  string
+		labelOrType string
+		properties  []string
+		want        string
+	}{
+		{
+			name:        "node range index",
+			indexType:   indexTypeRange,
+			entityType:  entityTypeNode,
+			labelOrType: "Person",
+			properties:  []string{"firstname", "surname"},
+			want:        "CREATE RANGE INDEX $name FOR (e:`Person`) ON (e.`firstname`, e.`surname`)",
+		},
+		{
+			name:        "relationship text index",
+			indexType:   indexTypeText,
+			entityType:  entityTypeRelationship,
+			labelOrType: "KNOWS",
+			properties:  []string{"since"},
+			want:        "CREATE TEXT INDEX $name FOR ()-[e:`KNOWS`]-() ON (e.`since`)",
+		},
+		{
+			name:        "unicode and special characters",
+			indexType:   indexTypeRange,
+			entityType:  entityTypeRelationship,
+			labelOrType: "is part-of ✓",
+			properties:  []string{"größe in m²"},
+			want:        "CREATE RANGE INDEX $name FOR ()-[e:`is part-of ✓`]-() ON (e.`größe in m²`)",
+		},
+		{
+			name:        "escaped names",
+			indexType:   indexTypePoint,
+			entityType:  entityTypeNode,
+			labelOrType: "Place`) DETACH DELETE e //",
+			properties:  []string{"location"},
+			want:        "CREATE POINT INDEX $name FOR (e:`Place``) DETACH DELETE e //`) ON (e.`location`)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, createIndexQuery(tt.indexType, tt.entityType, tt.labelOrType, tt.properties))
+		})
+	}
+}
+
+func TestClientWaitIndexOnline(t *testing.T) {
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err != nil {
+		t.Fatalf("could not connect to database: %v", err)
+	}
+	t.Cleanup(func() {
+		_, _ = c.Run(ctx, `MATCH (n:TestWaitIndex) DELETE n`, nil)
+		_, _ = c.Run(ctx, `DROP INDEX test_wait_index IF EXISTS`, nil)
+		_ = c.Close(ctx)
+	})
+
+	if _, err := c.Run(ctx, `UNWIND range(1, 1000) AS i CREATE (:TestWaitIndex{id: i})`, nil); err != nil {
+		t.Fatalf("failed to create the nodes: %v", err)
+	}
+	if _, err := c.Run(ctx, createIndexQuery(indexTypeRange, entityTypeNode, "TestWaitIndex", []string{"id"}),
+		map[string]any{"name": "test_wait_index"}); err != nil {
+		t.Fatalf("failed to create the index: %v", err)
+	}
+
+	diags := c.waitIndexOnline(ctx, "test_wait_index", time.Minute)
+	assert.False(t, diags.HasError(), "unexpected error: %v", diags)
+	state, err := c.indexState(ctx, "test_wait_index")
+	assert.NoError(t, err)
+	assert.Equal(t, indexStateOnline, state)
+
+	diags = c.waitIndexOnline(ctx, "test_missing_index", time.Second)
+	if assert.True(t, diags.HasError()) {
+		assert.Contains(t, diags[0].Detail(), "the index test_missing_index is not found")
+	}
+}
+
+func TestIndexOnlineTimeout(t *testing.T) {
+	timeout, diags := indexOnlineTimeout(types.StringNull())
+	assert.False(t, diags.HasError())
+	assert.Equal(t, indexOnlineDefaultTimeout, timeout)
+
+	timeout, diags = indexOnlineTimeout(types.StringValue("30s"))
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 30*time.Second, timeout)
+
+	_, diags = indexOnlineTimeout(types.StringValue("0s"))
+	assert.True(t, diags.HasError())
+}
+
+func TestAccIndexResource(t *testing.T) {
+	t.Setenv("DB_URI", testDbURI)
+	t.Setenv("DB_USER", testDBUser)
+	t.Cleanup(func() {
+		t.Setenv("DB_URI", "")
+		t.Setenv("DB_USER", "")
+	})
+
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err != nil {
+		t.Errorf("could not conenct to database: %v\n", err)
+		return
+	}
+	defer func() { _ = c.Close(ctx) }()
+
+	const (
+		nodeResourceAddress         = Name + indexSuffix + ".node"
+		relationshipResourceAddress = Name + indexSuffix + ".relationship"
+	)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "neo4j_index" "node" {
+name          = "person_name"
+entity_type   = "NODE"
+label_or_type = "Person"
+properties    = ["firstname", "surname"]
+
+wait_for_online = true
+online_timeout  = "1m"
+}
+
+resource "neo4j_index" "relationship" {
+name          = "knows_since"
+index_type    = "TEXT"
+entity_type   = "RELATIONSHIP"
+label_or_type = "KNOWS"
+properties    = ["since"]
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(nodeResourceAddress, tfjsonpath.New("index_type"),
+						knownvalue.StringExact(indexTypeRange)),
+					statecheck.ExpectKnownValue(relationshipResourceAddress, tfjsonpath.New("index_type"),
+						knownvalue.StringExact(indexTypeText)),
+					statecheck.ExpectKnownValue(relationshipResourceAddress, tfjsonpath.New("entity_type"),
+						knownvalue.StringExact(entityTypeRelationship)),
+				},
+			},
+			{
+				ResourceName:                         relationshipResourceAddress,
+				ImportState:                          true,
+				ImportStateId:                        "knows_since",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			{
+				// the test container runs the default database
+				ResourceName:                         relationshipResourceAddress,
+				ImportState:                          true,
+				ImportStateId:                        "neo4j/knows_since",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			{
+				ResourceName:  relationshipResourceAddress,
+				ImportState:   true,
+				ImportStateId: "movies/knows_since",
+				ExpectError:   regexp.MustCompile(`the resource belongs to the database movies`),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			r, err := c.Run(ctx, `SHOW INDEXES YIELD name WHERE name IN ["person_name", "knows_since"] RETURN name`,
+				nil)
+			if err != nil {
+				return err
+			}
+			assert.False(t, r.Next(ctx), "the indexes shall be deleted")
+			return nil
+		},
+	})
+}
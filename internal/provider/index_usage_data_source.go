@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IndexUsageDataSource{}
+
+func NewIndexUsageDataSource() datasource.DataSource {
+	return &IndexUsageDataSource{}
+}
+
+// IndexUsageDataSource defines the data source to read the indexes' usage statistics.
+type IndexUsageDataSource struct {
+	client *Client
+}
+
+// IndexUsageDataSourceModel describes the data source data model.
+type IndexUsageDataSourceModel struct {
+	NamePattern types.String `tfsdk:"name_pattern"`
+	Indexes     types.Map    `tfsdk:"indexes"`
+	Unused      types.List   `tfsdk:"unused"`
+}
+
+// IndexUsageModel describes the index usage statistics.
+type IndexUsageModel struct {
+	Type          types.String `tfsdk:"type"`
+	EntityType    types.String `tfsdk:"entity_type"`
+	LabelsOrTypes types.List   `tfsdk:"labels_or_types"`
+	Properties    types.List   `tfsdk:"properties"`
+	ReadCount     types.Int64  `tfsdk:"read_count"`
+	LastRead      types.String `tfsdk:"last_read"`
+	TrackedSince  types.String `tfsdk:"tracked_since"`
+}
+
+var indexUsageAttrTypes = map[string]attr.Type{
+	"type":            types.StringType,
+	"entity_type":     types.StringType,
+	"labels_or_types": types.ListType{ElemType: types.StringType},
+	"properties":      types.ListType{ElemType: types.StringType},
+	"read_count":      types.Int64Type,
+	"last_read":       types.StringType,
+	"tracked_since":   types.StringType,
+}
+
+const indexUsageSuffix = "_index_usage"
+
+// toTimeValue converts the temporal value returned by the database to the nullable RFC 3339 string.
+func toTimeValue(v any) types.String {
+	if t, ok := v.(time.Time); ok {
+		return types.StringValue(t.Format(time.RFC3339Nano))
+	}
+	return toStringValue(v)
+}
+
+func (d *IndexUsageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + indexUsageSuffix
+}
+
+func (d *IndexUsageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The indexes' usage statistics, e.g. to audit the unused indexes in the `check` blocks. " +
+			"Details: https://neo4j.com/docs/cypher-manual/current/indexes/search-performance-indexes/managing-indexes/#list-indexes" +
+			"\n\n-> **Note** The statistics are tracked since the index is created, or the database is started.",
+		Attributes: map[string]schema.Attribute{
+			"name_pattern": schema.StringAttribute{
+				MarkdownDescription: "Regular expression to filter the indexes by name. " +
+					"All indexes are returned if not set.",
+				Optional: true,
+			},
+			"indexes": schema.MapNestedAttribute{
+				MarkdownDescription: "The indexes' usage statistics by the indexes' names.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Index type, e.g. `RANGE`.",
+							Computed:            true,
+						},
+						"entity_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the indexed entities: `NODE` or `RELATIONSHIP`.",
+							Computed:            true,
+						},
+						"labels_or_types": schema.ListAttribute{
+							MarkdownDescription: "The labels of the indexed nodes, or the types of the indexed relationships.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"properties": schema.ListAttribute{
+							MarkdownDescription: "The indexed properties.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"read_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of the index reads.",
+							Computed:            true,
+						},
+						"last_read": schema.StringAttribute{
+							MarkdownDescription: "The time of the last index read, null if the index was not read.",
+							Computed:            true,
+						},
+						"tracked_since": schema.StringAttribute{
+							MarkdownDescription: "The time the statistics are tracked since.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"unused": schema.ListAttribute{
+				MarkdownDescription: "The names of the indexes which were not read.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *IndexUsageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *IndexUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+	var data IndexUsageDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pattern := cmp.Or(data.NamePattern.ValueString(), ".*")
+	tflog.Trace(ctx, "reading the indexes' usage", map[string]interface{}{"name_pattern": pattern})
+
+	dbResp, err := d.client.Run(ctx, `SHOW INDEXES
+YIELD name, type, entityType, labelsOrTypes, properties, readCount, lastRead, trackedSince
+WHERE name =~ $pattern
+RETURN name, type, entityType, labelsOrTypes, properties, readCount, lastRead, trackedSince
+ORDER BY name`, map[string]any{"pattern": pattern})
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the indexes' usage")
+		resp.Diagnostics.AddError("failed to read the indexes' usage", err.Error())
+		return
+	}
+
+	records, err := dbResp.Collect(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the indexes' usage")
+		resp.Diagnostics.AddError("failed to read the indexes' usage", err.Error())
+		return
+	}
+
+	var indexes = make(map[string]IndexUsageModel, len(records))
+	var unused = make([]string, 0)
+	for _, rec := range records {
+		m := rec.AsMap()
+		name := fmt.Sprintf("%v", m["name"])
+		index := IndexUsageModel{
+			Type:         toStringValue(m["type"]),
+			EntityType:   toStringValue(m["entityType"]),
+			ReadCount:    types.Int64Null(),
+			LastRead:     toTimeValue(m["lastRead"]),
+			TrackedSince: toTimeValue(m["trackedSince"]),
+		}
+		var diags diag.Diagnostics
+		index.LabelsOrTypes, diags = toLabels(ctx, m["labelsOrTypes"])
+		resp.Diagnostics.Append(diags...)
+		index.Properties, diags = toLabels(ctx, m["properties"])
+		resp.Diagnostics.Append(diags...)
+
+		readCount, ok := m["readCount"].(int64)
+		if ok {
+			index.ReadCount = types.Int64Value(readCount)
+		}
+		if readCount == 0 {
+			unused = append(unused, name)
+		}
+		indexes[name] = index
+	}
+
+	var diags diag.Diagnostics
+	data.Indexes, diags = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: indexUsageAttrTypes}, indexes)
+	resp.Diagnostics.Append(diags...)
+	data.Unused, diags = types.ListValueFrom(ctx, types.StringType, unused)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the indexes' usage", map[string]interface{}{"count": len(indexes)})
+}
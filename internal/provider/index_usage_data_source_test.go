@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccIndexUsageDataSource(t *testing.T) {
+	t.Setenv("DB_URI", testDbURI)
+	t.Setenv("DB_USER", testDBUser)
+	t.Cleanup(func() {
+		t.Setenv("DB_URI", "")
+		t.Setenv("DB_USER", "")
+	})
+
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err != nil {
+		t.Errorf("could not conenct to database: %v\n", err)
+		return
+	}
+	t.Cleanup(func() { _ = c.Close(ctx) })
+
+	for _, query := range []string{
+		`CREATE INDEX usage_unused IF NOT EXISTS FOR (n:IndexUsage) ON (n.unused)`,
+		`CREATE INDEX usage_used IF NOT EXISTS FOR (n:IndexUsage) ON (n.used)`,
+		`CALL db.awaitIndexes(60)`,
+		`MATCH (n:IndexUsage) WHERE n.used = "foo" RETURN n`,
+	} {
+		if _, err = c.Run(ctx, query, nil); err != nil {
+			t.Errorf("could not seed the indexes: %v\n", err)
+			return
+		}
+	}
+	t.Cleanup(func() {
+		_, _ = c.Run(ctx, `DROP INDEX usage_unused IF EXISTS`, nil)
+		_, _ = c.Run(ctx, `DROP INDEX usage_used IF EXISTS`, nil)
+	})
+
+	const dataSourceAddress = "data." + Name + indexUsageSuffix + "._"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "neo4j_index_usage" "_" {
+name_pattern = "usage_.*"
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("unused"),
+						knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("usage_unused")})),
+					statecheck.ExpectKnownValue(dataSourceAddress,
+						tfjsonpath.New("indexes").AtMapKey("usage_unused"),
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"type":        knownvalue.StringExact("RANGE"),
+							"entity_type": knownvalue.StringExact("NODE"),
+							"labels_or_types": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.StringExact("IndexUsage"),
+							}),
+							"properties": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.StringExact("unused"),
+							}),
+							"read_count":    knownvalue.Int64Exact(0),
+							"last_read":     knownvalue.Null(),
+							"tracked_since": knownvalue.StringRegexp(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T`)),
+						})),
+					statecheck.ExpectKnownValue(dataSourceAddress,
+						tfjsonpath.New("indexes").AtMapKey("usage_used").AtMapKey("last_read"),
+						knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
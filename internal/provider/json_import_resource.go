@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &JSONImportResource{}
+var _ resource.ResourceWithModifyPlan = &JSONImportResource{}
+var _ resource.ResourceWithValidateConfig = &JSONImportResource{}
+
+func NewJSONImportResource() resource.Resource {
+	return &JSONImportResource{}
+}
+
+// JSONImportResource defines the resource to import the JSON document to the graph.
+type JSONImportResource struct {
+	client *Client
+}
+
+// JSONImportResourceModel describes the resource data model.
+type JSONImportResourceModel struct {
+	URL            types.String `tfsdk:"url"`
+	Format         types.String `tfsdk:"format"`
+	Statement      types.String `tfsdk:"statement"`
+	BatchSize      types.Int64  `tfsdk:"batch_size"`
+	ValidateOnPlan types.Bool   `tfsdk:"validate_on_plan"`
+	Checksum       types.String `tfsdk:"checksum"`
+}
+
+const jsonImportSuffix = "_json_import"
+
+func (r *JSONImportResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + jsonImportSuffix
+}
+
+func (r *JSONImportResource) Schema(_ context.Context, _ resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Imports the JSON document to the graph using APOC, details: " +
+			"https://neo4j.com/docs/apoc/current/import/load-json/" +
+			"\n\n-> **Note** The document is imported again when its checksum changes. " +
+			"The imported data is kept when the resource is destroyed." +
+			"\n\n!>**Warning** The resource requires the APOC plugin. " +
+			"The import from file requires `apoc.import.file.enabled=true`.",
+		Attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the JSON document, e.g. `https://`, `s3://`, `gs://`, or `file://`.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"format": schema.StringAttribute{
+				MarkdownDescription: "The document's format: `apoc` to map the document's objects using the `statement`, " +
+					"or `arrows` to create the graph drawn with Arrows.app, details: https://arrows.app. " +
+					"The Arrows.app nodes and relationships are created with their labels, type and properties, " +
+					"and the `uuid` property to import them to the `neo4j_node` and `neo4j_relationship` resources. " +
+					"Defaults to `apoc`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(importFormatAPOC),
+				Validators: []validator.String{
+					stringvalidator.OneOf(importFormatAPOC, importFormatArrows),
+				},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"statement": schema.StringAttribute{
+				MarkdownDescription: "Cypher statement to map the document to the graph. " +
+					"The document's objects are available as `value`, e.g. `MERGE (:Person{name: value.name})`. " +
+					"Required unless the `format` is `arrows`.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"batch_size": schema.Int64Attribute{
+				MarkdownDescription: "Set to import the document in batches of the given number of objects, " +
+					"each committed in its own transaction using `apoc.periodic.iterate`. " +
+					"It keeps the transaction memory bounded when large documents are imported. " +
+					"The document is imported in a single transaction if not set.",
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"validate_on_plan": schema.BoolAttribute{
+				MarkdownDescription: "Set to validate the statement with `EXPLAIN` when the plan is made. " +
+					"Disable it when the database is not reachable at plan time.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"checksum": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 checksum of the imported document.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *JSONImportResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *JSONImportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse) {
+	var data JSONImportResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Format.IsUnknown() {
+		return
+	}
+
+	if data.Format.ValueString() != importFormatArrows {
+		if data.Statement.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("statement"), "missing statement",
+				"the statement is required to map the document to the graph")
+		}
+		return
+	}
+	for _, v := range []struct {
+		name  string
+		isSet bool
+	}{
+		{"statement", !data.Statement.IsNull()},
+		{"batch_size", !data.BatchSize.IsNull()},
+	} {
+		if v.isSet {
+			resp.Diagnostics.AddAttributeError(path.Root(v.name), "invalid attribute combination",
+				fmt.Sprintf("%s is not supported for the %s format", v.name, importFormatArrows))
+		}
+	}
+}
+
+// jsonChecksumQuery defines the query to calculate the checksum of the JSON document.
+const jsonChecksumQuery = `CALL apoc.load.json($url) YIELD value
+WITH collect(value) AS values
+RETURN apoc.util.sha256([apoc.convert.toJson(values)])`
+
+// toChecksum extracts the checksum from the records returned by jsonChecksumQuery.
+func toChecksum(records []*neo4j.Record) (string, error) {
+	if len(records) != 1 {
+		return "", fmt.Errorf("expected a single checksum, got %d records", len(records))
+	}
+	v, ok := records[0].Values[0].(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected checksum: %v", records[0].Values[0])
+	}
+	return v, nil
+}
+
+// checksum calculates the checksum of the JSON document.
+func (r *JSONImportResource) checksum(ctx context.Context, url string) (string, error) {
+	dbResp, err := r.client.Run(ctx, jsonChecksumQuery, map[string]any{"url": url})
+	if err != nil {
+		return "", err
+	}
+	records, err := dbResp.Collect(ctx)
+	if err != nil {
+		return "", err
+	}
+	return toChecksum(records)
+}
+
+// importStatement defines the statement to run the configured statement for every object of the JSON document,
+// or to create the graph of the Arrows.app document.
+func importStatement(data *JSONImportResourceModel) statement {
+	if data.Format.ValueString() == importFormatArrows {
+		return statement{query: arrowsImportQuery, params: map[string]any{"url": data.URL.ValueString()}}
+	}
+	if data.BatchSize.IsNull() {
+		return statement{
+			query:  "CALL apoc.load.json($url) YIELD value\n" + data.Statement.ValueString(),
+			params: map[string]any{"url": data.URL.ValueString()},
+		}
+	}
+	return statement{
+		query: `CALL apoc.periodic.iterate(
+"CALL apoc.load.json($url) YIELD value RETURN value",
+$statement,
+{batchSize: $batchSize, params: {url: $url}}
+)
+YIELD failedBatches, errorMessages
+RETURN failedBatches, errorMessages`,
+		params: map[string]any{
+			"url":       data.URL.ValueString(),
+			"statement": data.Statement.ValueString(),
+			"batchSize": data.BatchSize.ValueInt64(),
+		},
+	}
+}
+
+// checkImported checks the records returned by importStatement for the failed batches.
+func checkImported(data *JSONImportResourceModel, records []*neo4j.Record) error {
+	if data.BatchSize.IsNull() {
+		return nil
+	}
+	if len(records) != 1 {
+		return fmt.Errorf("expected a single import result, got %d records", len(records))
+	}
+	// The failed batches are rolled back by APOC, but the procedure itself succeeds.
+	m := records[0].AsMap()
+	if v, ok := m["failedBatches"].(int64); ok && v > 0 {
+		return fmt.Errorf("%d batches failed: %v", v, m["errorMessages"])
+	}
+	return nil
+}
+
+// importDocument calculates the checksum of the JSON document, and runs the statement for its every object.
+// Both statements are pipelined in a single transaction to save the round trip.
+func (r *JSONImportResource) importDocument(ctx context.Context, data *JSONImportResourceModel) (string, error) {
+	results, err := r.client.RunPipelined(ctx,
+		statement{query: jsonChecksumQuery, params: map[string]any{"url": data.URL.ValueString()}},
+		importStatement(data),
+	)
+	if err != nil {
+		return "", err
+	}
+	checksum, err := toChecksum(results[0])
+	if err != nil {
+		return "", err
+	}
+	return checksum, checkImported(data, results[1])
+}
+
+func (r *JSONImportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan JSONImportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ValidateOnPlan.ValueBool() && !plan.Statement.IsNull() && !plan.Statement.IsUnknown() {
+		if err := r.client.Explain(ctx, "CALL apoc.load.json($url) YIELD value\n"+plan.Statement.ValueString(),
+			map[string]any{"url": plan.URL.ValueString()}); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("statement"), "invalid statement", err.Error())
+			return
+		}
+	}
+
+	// The document's checksum is only compared for the existing resource.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state JSONImportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.URL.IsUnknown() || !plan.URL.Equal(state.URL) {
+		return
+	}
+
+	checksum, err := r.checksum(ctx, plan.URL.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning("failed to calculate the document's checksum",
+			fmt.Sprintf("the changes of the document cannot be detected: %v", err))
+		return
+	}
+
+	if checksum != state.Checksum.ValueString() {
+		tflog.Debug(ctx, "the document changed", map[string]interface{}{"url": plan.URL.ValueString()})
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("checksum"), types.StringUnknown())...)
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("checksum"))
+	}
+}
+
+func (r *JSONImportResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+	var data JSONImportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+jsonImportSuffix, auditOperationCreate, &data.URL)
+
+	props := map[string]interface{}{"url": data.URL.ValueString()}
+	tflog.Trace(ctx, "import the json document", props)
+
+	checksum, err := r.importDocument(ctx, &data)
+	if err != nil {
+		tflog.Debug(ctx, "failed to import the json document", props)
+		resp.Diagnostics.AddError("failed to import the json document", err.Error())
+		return
+	}
+
+	data.Checksum = types.StringValue(checksum)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "imported the json document", props)
+}
+
+func (r *JSONImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The import is a one-off action, the document's changes are detected when the plan is modified.
+	var data JSONImportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *JSONImportResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+	// The batch size and the validation flag only affect the next import, hence the plan is only copied to the state.
+	var data JSONImportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *JSONImportResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// The imported data is kept.
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccJSONImportResource(t *testing.T) {
+	t.Setenv("DB_URI", testDbURI)
+	t.Setenv("DB_USER", testDBUser)
+	t.Cleanup(func() {
+		t.Setenv("DB_URI", "")
+		t.Setenv("DB_USER", "")
+	})
+
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err != nil {
+		t.Errorf("could not conenct to database: %v\n", err)
+		return
+	}
+	t.Cleanup(func() { _ = c.Close(ctx) })
+	t.Cleanup(func() {
+		_, _ = c.Run(ctx, `MATCH (n:JSONImport) DETACH DELETE n`, nil)
+	})
+
+	// writeDocument writes the JSON document with the given number of objects to the import directory.
+	writeDocument := func(n int) {
+		if _, err := c.Run(ctx, `CALL apoc.export.json.query("UNWIND range(1, $n) AS id RETURN id",
+"json-import.json", {params: {n: $n}})`, map[string]any{"n": n}); err != nil {
+			t.Errorf("could not write the document: %v\n", err)
+		}
+	}
+
+	// checkImported checks the number of imported nodes.
+	checkImported := func(want int64) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			r, err := c.Run(ctx, `MATCH (n:JSONImport) RETURN count(n)`, nil)
+			if err != nil {
+				return err
+			}
+			rec, err := r.Single(ctx)
+			if err != nil {
+				return err
+			}
+			if got := rec.Values[0].(int64); got != want {
+				return fmt.Errorf("expected %d imported nodes, got %d", want, got)
+			}
+			return nil
+		}
+	}
+
+	const (
+		resourceAddress = Name + jsonImportSuffix + "._"
+		config          = `resource "neo4j_json_import" "_" {
+url       = "file:///json-import.json"
+statement = "MERGE (:JSONImport{id: value.id})"
+}`
+		configBatched = `resource "neo4j_json_import" "_" {
+url        = "file:///json-import.json"
+statement  = "MERGE (:JSONImport{id: value.id})"
+batch_size = 2
+}`
+	)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the statement is not supported for the arrows format
+			{
+				Config: `resource "neo4j_json_import" "_" {
+url       = "file:///json-import.json"
+format    = "arrows"
+statement = "MERGE (:JSONImport{id: value.id})"
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("statement is not supported for the arrows format"),
+			},
+			// the faulty statement is rejected when the plan is made
+			{
+				Config: `resource "neo4j_json_import" "_" {
+url       = "file:///json-import.json"
+statement = "MERGE (:JSONImport{id: value.id}"
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("invalid statement"),
+			},
+			{
+				PreConfig: func() { writeDocument(3) },
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceAddress, "checksum"),
+					checkImported(3),
+				),
+			},
+			// the changed document is imported again
+			{
+				PreConfig: func() { writeDocument(4) },
+				Config:    config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceAddress, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: checkImported(4),
+			},
+			// the changed batch size does not trigger the import
+			{
+				Config: configBatched,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceAddress, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			// the changed document is imported in batches
+			{
+				PreConfig: func() { writeDocument(5) },
+				Config:    configBatched,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceAddress, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: checkImported(5),
+			},
+		},
+	})
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LabelRenameResource{}
+
+func NewLabelRenameResource() resource.Resource {
+	return &LabelRenameResource{}
+}
+
+// LabelRenameResource defines the resource to rename the label of the nodes.
+type LabelRenameResource struct {
+	client *Client
+}
+
+// LabelRenameResourceModel describes the resource data model.
+type LabelRenameResourceModel struct {
+	From      types.String `tfsdk:"from"`
+	To        types.String `tfsdk:"to"`
+	BatchSize types.Int64  `tfsdk:"batch_size"`
+	Renamed   types.Int64  `tfsdk:"renamed"`
+}
+
+const (
+	labelRenameSuffix = "_label_rename"
+
+	labelRenameDefaultBatchSize = 10000
+)
+
+// labelRenameStatements defines the statements to iterate over the nodes which have the label,
+// and to replace it with the new one. The labels cannot be passed as parameters,
+// hence they are escaped and embedded into the statements.
+func labelRenameStatements(from, to string) (iterate, action string) {
+	iterate = "MATCH (e:" + quoteName(from) + ") RETURN e"
+	action = "SET e:" + quoteName(to) + " REMOVE e:" + quoteName(from)
+	return iterate, action
+}
+
+func (r *LabelRenameResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + labelRenameSuffix
+}
+
+func (r *LabelRenameResource) Schema(_ context.Context, _ resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Renames the label of all nodes in batches using APOC, details: " +
+			"https://neo4j.com/docs/apoc/current/overview/apoc.periodic/apoc.periodic.iterate/" +
+			"\n\n-> **Note** The label is renamed once when the resource is created, " +
+			"and again only when the resource is replaced. The renamed label is kept when the resource is destroyed." +
+			"\n\n!>**Warning** The resource requires the APOC plugin. " +
+			"The indexes and constraints on the renamed label are not migrated.",
+		Attributes: map[string]schema.Attribute{
+			"from": schema.StringAttribute{
+				MarkdownDescription: "The label to rename.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "The new label.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"batch_size": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The number of nodes relabeled in a single transaction. "+
+					"Defaults to %d.", labelRenameDefaultBatchSize),
+				Optional:   true,
+				Computed:   true,
+				Default:    int64default.StaticInt64(labelRenameDefaultBatchSize),
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"renamed": schema.Int64Attribute{
+				MarkdownDescription: "The number of nodes the label was renamed on.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *LabelRenameResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// rename renames the label in batches, and returns the number of the nodes it was renamed on.
+func (r *LabelRenameResource) rename(ctx context.Context, data LabelRenameResourceModel) (int64, error) {
+	iterate, action := labelRenameStatements(data.From.ValueString(), data.To.ValueString())
+	dbResp, err := r.client.Run(ctx, `CALL apoc.periodic.iterate($iterate, $action, {batchSize: $batchSize})
+YIELD total, failedBatches, errorMessages
+RETURN total, failedBatches, errorMessages`, map[string]any{
+		"iterate":   iterate,
+		"action":    action,
+		"batchSize": data.BatchSize.ValueInt64(),
+	})
+	if err != nil {
+		return 0, err
+	}
+	rec, err := dbResp.Single(ctx)
+	if err != nil {
+		return 0, err
+	}
+	// The failed batches are rolled back by APOC, but the procedure itself succeeds.
+	m := rec.AsMap()
+	if v, ok := m["failedBatches"].(int64); ok && v > 0 {
+		return 0, fmt.Errorf("%d batches failed: %v", v, m["errorMessages"])
+	}
+	total, _ := m["total"].(int64)
+	return total, nil
+}
+
+func (r *LabelRenameResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+	var data LabelRenameResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+labelRenameSuffix, auditOperationCreate, &data.From)
+
+	if data.From.Equal(data.To) {
+		resp.Diagnostics.AddAttributeError(path.Root("to"), "invalid label",
+			"the new label shall differ from the renamed one")
+		return
+	}
+
+	props := map[string]interface{}{"from": data.From.ValueString(), "to": data.To.ValueString()}
+	tflog.Trace(ctx, "rename the label", props)
+
+	renamed, err := r.rename(ctx, data)
+	if err != nil {
+		tflog.Debug(ctx, "failed to rename the label", props)
+		resp.Diagnostics.AddError("failed to rename the label", err.Error())
+		return
+	}
+
+	data.Renamed = types.Int64Value(renamed)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "renamed the label", props)
+}
+
+func (r *LabelRenameResource) Read(ctx context.Context, req resource.ReadRequest,
+	resp *resource.ReadResponse) {
+	// The rename is a one-off action, hence the state is kept as is.
+	var data LabelRenameResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LabelRenameResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+	// The batch size only affects the next rename, hence the plan is only copied to the state.
+	var data LabelRenameResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LabelRenameResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// The renamed label is kept.
+}
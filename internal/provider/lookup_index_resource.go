@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LookupIndexResource{}
+var _ resource.ResourceWithImportState = &LookupIndexResource{}
+
+func NewLookupIndexResource() resource.Resource {
+	return &LookupIndexResource{}
+}
+
+// LookupIndexResource defines the token lookup index resource implementation.
+type LookupIndexResource struct {
+	client *Client
+}
+
+// LookupIndexResourceModel describes the resource data model.
+type LookupIndexResourceModel struct {
+	Name       types.String `tfsdk:"name"`
+	EntityType types.String `tfsdk:"entity_type"`
+
+	WaitForOnline types.Bool   `tfsdk:"wait_for_online"`
+	OnlineTimeout types.String `tfsdk:"online_timeout"`
+}
+
+const (
+	lookupIndexSuffix = "_lookup_index"
+
+	entityTypeNode         = "NODE"
+	entityTypeRelationship = "RELATIONSHIP"
+)
+
+func (r *LookupIndexResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + lookupIndexSuffix
+}
+
+func (r *LookupIndexResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Neo4j token lookup index, details: " +
+			"https://neo4j.com/docs/cypher-manual/current/indexes/search-performance-indexes/overview/#lookup-indexes" +
+			"\n\n-> **Note** Only one lookup index per entity type can exist in a database. " +
+			"Use the import to manage the lookup index created by default.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Index name.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"entity_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the indexed entities' tokens: " +
+					"`NODE` for the node labels, `RELATIONSHIP` for the relationship types.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.OneOf(entityTypeNode, entityTypeRelationship),
+				},
+			},
+			"wait_for_online": waitForOnlineAttribute,
+			"online_timeout":  onlineTimeoutAttribute,
+		},
+	}
+}
+
+func (r *LookupIndexResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *LookupIndexResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+	var data LookupIndexResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+lookupIndexSuffix, auditOperationCreate, &data.Name)
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "create a lookup index", props)
+
+	timeout, diags := indexOnlineTimeout(data.OnlineTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var query string
+	switch data.EntityType.ValueString() {
+	case entityTypeNode:
+		query = `CREATE LOOKUP INDEX $name FOR (n) ON EACH labels(n)`
+	default:
+		query = `CREATE LOOKUP INDEX $name FOR ()-[r]-() ON EACH type(r)`
+	}
+
+	if _, err := r.client.Run(ctx, query, map[string]any{"name": data.Name.ValueString()}); err != nil {
+		tflog.Debug(ctx, "failed to create the lookup index", props)
+		resp.Diagnostics.AddError("failed to create the lookup index", err.Error())
+		return
+	}
+
+	// The state is set even if the index doesn't get online, hence the index is tainted instead of being left behind.
+	if data.WaitForOnline.ValueBool() {
+		tflog.Trace(ctx, "waiting for the lookup index to be online", props)
+		if diags := r.client.waitIndexOnline(ctx, data.Name.ValueString(), timeout); diags.HasError() {
+			tflog.Debug(ctx, "failed to wait for the lookup index to be online", props)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "created a lookup index", props)
+}
+
+func (r *LookupIndexResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LookupIndexResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "reading the lookup index", props)
+
+	dbResp, err := r.client.Run(ctx, `SHOW LOOKUP INDEXES YIELD name, entityType WHERE name = $name
+RETURN entityType`, map[string]any{"name": data.Name.ValueString()})
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the lookup index", props)
+		resp.Diagnostics.AddError("failed to read the lookup index", err.Error())
+		return
+	}
+
+	var rec *neo4j.Record
+	if !dbResp.NextRecord(ctx, &rec) {
+		// The index was dropped outside of Terraform, hence it shall be recreated.
+		tflog.Debug(ctx, "no lookup index found", props)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.EntityType = types.StringValue(rec.Values[0].(string))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the lookup index", props)
+}
+
+func (r *LookupIndexResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+	// All attributes require replacement, hence the plan is only copied to the state.
+	var data LookupIndexResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LookupIndexResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+	var data LookupIndexResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+lookupIndexSuffix, auditOperationDelete, &data.Name)
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "delete the lookup index", props)
+	if _, err := r.client.Run(ctx, `DROP INDEX $name IF EXISTS`,
+		map[string]any{"name": data.Name.ValueString()}); err != nil {
+		tflog.Debug(ctx, "failed to delete the lookup index", props)
+		resp.Diagnostics.AddError("failed to delete the lookup index", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "deleted the lookup index", props)
+}
+
+func (r *LookupIndexResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+	name, err := r.client.importID(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("unexpected import identifier", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccLookupIndexResource(t *testing.T) {
+	t.Setenv("DB_URI", testDbURI)
+	t.Setenv("DB_USER", testDBUser)
+	t.Cleanup(func() {
+		t.Setenv("DB_URI", "")
+		t.Setenv("DB_USER", "")
+	})
+
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err != nil {
+		t.Errorf("could not conenct to database: %v\n", err)
+		return
+	}
+	defer func() { _ = c.Close(ctx) }()
+
+	// Only one relationship type lookup index can exist, hence the default one is dropped.
+	r, err := c.Run(ctx, `SHOW LOOKUP INDEXES YIELD name, entityType WHERE entityType = "RELATIONSHIP"
+RETURN name`, nil)
+	if err != nil {
+		t.Errorf("could not read the lookup indexes: %v\n", err)
+		return
+	}
+	if rec, err := r.Single(ctx); err == nil {
+		if _, err = c.Run(ctx, `DROP INDEX $name`, map[string]any{"name": rec.Values[0]}); err != nil {
+			t.Errorf("could not drop the default lookup index: %v\n", err)
+			return
+		}
+	}
+	t.Cleanup(func() {
+		_, _ = c.Run(ctx, `CREATE LOOKUP INDEX IF NOT EXISTS FOR ()-[r]-() ON EACH type(r)`, nil)
+	})
+
+	const resourceAddress = Name + lookupIndexSuffix + "._"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "neo4j_lookup_index" "_" {
+name            = "rel_type_lookup"
+entity_type     = "RELATIONSHIP"
+wait_for_online = true
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceAddress, tfjsonpath.New("name"),
+						knownvalue.StringExact("rel_type_lookup")),
+					statecheck.ExpectKnownValue(resourceAddress, tfjsonpath.New("entity_type"),
+						knownvalue.StringExact("RELATIONSHIP")),
+				},
+			},
+			{
+				ResourceName:                         resourceAddress,
+				ImportState:                          true,
+				ImportStateId:                        "rel_type_lookup",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				// the wait only applies to the creation, hence it's not read from the database
+				ImportStateVerifyIgnore: []string{"wait_for_online"},
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			r, err := c.Run(ctx, `SHOW LOOKUP INDEXES YIELD name WHERE name = "rel_type_lookup" RETURN name`, nil)
+			if err != nil {
+				return err
+			}
+			assert.False(t, r.Next(ctx), "the index shall be deleted")
+			return nil
+		},
+	})
+}
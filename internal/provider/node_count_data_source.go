@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NodeCountDataSource{}
+
+func NewNodeCountDataSource() datasource.DataSource {
+	return &NodeCountDataSource{}
+}
+
+// NodeCountDataSource defines the data source to count the nodes, the relationships and the node's degree.
+type NodeCountDataSource struct {
+	client *Client
+}
+
+// NodeCountDataSourceModel describes the data source data model.
+type NodeCountDataSourceModel struct {
+	Labels           types.List   `tfsdk:"labels"`
+	RelationshipType types.String `tfsdk:"relationship_type"`
+	NodeID           types.String `tfsdk:"node_id"`
+	Nodes            types.Int64  `tfsdk:"nodes"`
+	Relationships    types.Int64  `tfsdk:"relationships"`
+	Degree           types.Int64  `tfsdk:"degree"`
+}
+
+const nodeCountSuffix = "_node_count"
+
+func (d *NodeCountDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + nodeCountSuffix
+}
+
+func (d *NodeCountDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Counts the nodes, the relationships and the degree of the node, " +
+			"e.g. to validate the provisioned graph in the `check` blocks.",
+		Attributes: map[string]schema.Attribute{
+			"labels": schema.ListAttribute{
+				MarkdownDescription: "The labels of the nodes to count. All nodes are counted if not set.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"relationship_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the relationships to count, and to calculate the degree by. " +
+					"All relationships are counted if not set.",
+				Optional: true,
+			},
+			"node_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the node to calculate the degree of.",
+				Optional:            true,
+			},
+			"nodes": schema.Int64Attribute{
+				MarkdownDescription: "The number of the nodes with the given labels.",
+				Computed:            true,
+			},
+			"relationships": schema.Int64Attribute{
+				MarkdownDescription: "The number of the relationships of the given type.",
+				Computed:            true,
+			},
+			"degree": schema.Int64Attribute{
+				MarkdownDescription: "The number of the relationships of the node given by `node_id`, " +
+					"null if `node_id` is not set.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *NodeCountDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// count runs the query which returns a single number.
+func (d *NodeCountDataSource) count(ctx context.Context, query string, params map[string]any) (int64, error) {
+	dbResp, err := d.client.Run(ctx, query, params)
+	if err != nil {
+		return 0, err
+	}
+	rec, err := dbResp.Single(ctx)
+	if err != nil {
+		return 0, err
+	}
+	v, ok := rec.Values[0].(int64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected count: %v", rec.Values[0])
+	}
+	return v, nil
+}
+
+func (d *NodeCountDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+	var data NodeCountDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var labels []string
+	resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &labels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "counting the graph elements")
+
+	nodesQuery := `MATCH (n) RETURN count(n)`
+	if len(labels) > 0 {
+		nodesQuery = `MATCH (n:$all($labels)) RETURN count(n)`
+	}
+	nodes, err := d.count(ctx, nodesQuery, map[string]any{"labels": labels})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to count the nodes", err.Error())
+		return
+	}
+	data.Nodes = types.Int64Value(nodes)
+
+	relationshipsQuery := `MATCH ()-[r]->() RETURN count(r)`
+	degreeQuery := `MATCH (n{uuid:$uuid}) RETURN COUNT { (n)-[]-() }`
+	if !data.RelationshipType.IsNull() {
+		relationshipsQuery = `MATCH ()-[r:$($type)]->() RETURN count(r)`
+		degreeQuery = `MATCH (n{uuid:$uuid}) RETURN COUNT { (n)-[:$($type)]-() }`
+	}
+	relationships, err := d.count(ctx, relationshipsQuery,
+		map[string]any{"type": data.RelationshipType.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to count the relationships", err.Error())
+		return
+	}
+	data.Relationships = types.Int64Value(relationships)
+
+	data.Degree = types.Int64Null()
+	if !data.NodeID.IsNull() {
+		degree, err := d.count(ctx, degreeQuery, map[string]any{
+			"uuid": data.NodeID.ValueString(),
+			"type": data.RelationshipType.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("failed to calculate the node's degree", err.Error())
+			return
+		}
+		data.Degree = types.Int64Value(degree)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "counted the graph elements")
+}
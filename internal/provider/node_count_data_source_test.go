@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccNodeCountDataSource(t *testing.T) {
+	t.Setenv("DB_URI", testDbURI)
+	t.Setenv("DB_USER", testDBUser)
+	t.Cleanup(func() {
+		t.Setenv("DB_URI", "")
+		t.Setenv("DB_USER", "")
+	})
+
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err != nil {
+		t.Errorf("could not conenct to database: %v\n", err)
+		return
+	}
+	t.Cleanup(func() { _ = c.Close(ctx) })
+
+	if _, err = c.Run(ctx, `CREATE (a:NodeCount:Hub{uuid:"count-0"}),
+(b:NodeCount{uuid:"count-1"}), (c:NodeCount{uuid:"count-2"}),
+(a)-[:NODE_COUNT_LINK]->(b), (a)-[:NODE_COUNT_LINK]->(c), (b)-[:NODE_COUNT_OTHER]->(a)`,
+		nil); err != nil {
+		t.Errorf("could not seed the graph: %v\n", err)
+		return
+	}
+	t.Cleanup(func() {
+		_, _ = c.Run(ctx, `MATCH (n:NodeCount) DETACH DELETE n`, nil)
+	})
+
+	const dataSourceAddress = "data." + Name + nodeCountSuffix + "._"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "neo4j_node_count" "_" {
+labels            = ["NodeCount"]
+relationship_type = "NODE_COUNT_LINK"
+node_id           = "count-0"
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("nodes"),
+						knownvalue.Int64Exact(3)),
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("relationships"),
+						knownvalue.Int64Exact(2)),
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("degree"),
+						knownvalue.Int64Exact(2)),
+				},
+			},
+			{
+				Config: `data "neo4j_node_count" "_" {
+labels  = ["NodeCount", "Hub"]
+node_id = "count-0"
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("nodes"),
+						knownvalue.Int64Exact(1)),
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("degree"),
+						knownvalue.Int64Exact(3)),
+				},
+			},
+			{
+				Config: `data "neo4j_node_count" "_" {
+labels = ["NodeCount"]
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("degree"),
+						knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
@@ -6,15 +6,29 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"regexp"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -24,6 +38,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &NodeResource{}
 var _ resource.ResourceWithImportState = &NodeResource{}
+var _ resource.ResourceWithModifyPlan = &NodeResource{}
 
 func NewNodeResource() resource.Resource {
 	return &NodeResource{}
@@ -31,14 +46,81 @@ func NewNodeResource() resource.Resource {
 
 // NodeResource defines the `Node` resource implementation.
 type NodeResource struct {
-	client neo4j.SessionWithContext
+	client *Client
 }
 
 // NodeResourceModel describes the resource data model.
 type NodeResourceModel struct {
-	Labels     types.List   `tfsdk:"labels"`
-	Properties types.Map    `tfsdk:"properties"`
-	ID         types.String `tfsdk:"id"`
+	Labels          types.List   `tfsdk:"labels"`
+	ExtraLabelsFrom types.List   `tfsdk:"extra_labels_from"`
+	Properties      types.Map    `tfsdk:"properties"`
+	ID              types.String `tfsdk:"id"`
+	Statements      types.List   `tfsdk:"statements"`
+
+	AdoptIfExists types.Bool `tfsdk:"adopt_if_exists"`
+	AdoptSelector types.Map  `tfsdk:"adopt_selector"`
+	NaturalKey    types.List `tfsdk:"natural_key"`
+
+	UniqueProperties types.List `tfsdk:"unique_properties"`
+	VerifyAfterWrite types.Bool `tfsdk:"verify_after_write"`
+
+	PropertyTypes types.Map  `tfsdk:"property_types"`
+	CoerceTypes   types.Bool `tfsdk:"coerce_types"`
+
+	ExecuteAsRole types.String `tfsdk:"execute_as_role"`
+
+	Preconditions  types.List `tfsdk:"preconditions"`
+	Postconditions types.List `tfsdk:"postconditions"`
+
+	UpdateMode types.String `tfsdk:"update_mode"`
+}
+
+// isMerged checks if the properties are merged onto the node's existing properties when it's updated.
+func (n NodeResourceModel) isMerged() bool {
+	return n.UpdateMode.ValueString() == nodeUpdateModeMerge
+}
+
+// updateQuery defines the query to update the node according to the update mode.
+func (n NodeResourceModel) updateQuery() string {
+	if n.isMerged() {
+		return nodeMergeQuery
+	}
+	return nodeUpdateQuery
+}
+
+// ReadProperties reads the configured properties. The properties set to null are kept as nil
+// in the merge update mode, hence they are removed by the update query, and they are rejected otherwise.
+func (n NodeResourceModel) ReadProperties(ctx context.Context) (o map[string]any, diags diag.Diagnostics) {
+	props, removed := n.Properties, []string(nil)
+	if n.isMerged() && !props.IsNull() && !props.IsUnknown() {
+		var elements = map[string]attr.Value{}
+		for k, v := range props.Elements() {
+			if v.IsNull() {
+				removed = append(removed, k)
+				continue
+			}
+			elements[k] = v
+		}
+		var d diag.Diagnostics
+		props, d = types.MapValue(types.StringType, elements)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	o, d := readProperties(ctx, props, n.PropertyTypes, n.CoerceTypes)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if o == nil && len(removed) > 0 {
+		o = make(map[string]any, len(removed))
+	}
+	for _, k := range removed {
+		o[k] = nil
+	}
+	return o, diags
 }
 
 func (n NodeResourceModel) ReadLabels(ctx context.Context) (o []string, diags diag.Diagnostics) {
@@ -63,13 +145,116 @@ func (n NodeResourceModel) ReadLabels(ctx context.Context) (o []string, diags di
 	return o, diags
 }
 
-func readProperties(ctx context.Context, props types.Map) (o map[string]any, diags diag.Diagnostics) {
+const (
+	propertyTypeString   = "string"
+	propertyTypeInt      = "int"
+	propertyTypeFloat    = "float"
+	propertyTypeBool     = "bool"
+	propertyTypeDatetime = "datetime"
+	propertyTypePoint    = "point"
+	propertyTypeBytes    = "bytes"
+)
+
+// propertyTypesDescription documents the attribute to set the properties' types.
+const propertyTypesDescription = "The types of the properties: " +
+	"`string`, `int`, `float`, `bool`, `datetime` (RFC 3339), `point` (`longitude,latitude[,height]` WGS-84), " +
+	"or `bytes` (base64 encoded). " +
+	"The type of the property not listed is guessed by parsing its value, " +
+	"e.g. \"01234\" is stored as the integer 1234 unless its type is set to `string`."
+
+// coerceTypesDescription documents the attribute to opt-out of the properties' types guessing.
+const coerceTypesDescription = "Set to false to store the properties not listed in `property_types` as strings verbatim. " +
+	"Their types are guessed by parsing the values by default, e.g. \"7\" is stored as the integer 7."
+
+// propertyTypesValidators validates the properties' types.
+var propertyTypesValidators = []validator.Map{
+	mapvalidator.ValueStringsAre(stringvalidator.OneOf(propertyTypeString, propertyTypeInt, propertyTypeFloat,
+		propertyTypeBool, propertyTypeDatetime, propertyTypePoint, propertyTypeBytes)),
+}
+
+// convertProperty converts the property's value to the given type, the type is guessed if not set.
+func convertProperty(s string, propertyType string) (any, error) {
+	switch propertyType {
+	case propertyTypeString:
+		return s, nil
+	case propertyTypeInt:
+		return strconv.ParseInt(s, 10, 64)
+	case propertyTypeFloat:
+		return strconv.ParseFloat(s, 64)
+	case propertyTypeBool:
+		return strconv.ParseBool(s)
+	case propertyTypeDatetime:
+		return time.Parse(time.RFC3339Nano, s)
+	case propertyTypeBytes:
+		return base64.StdEncoding.DecodeString(s)
+	case propertyTypePoint:
+		var coordinates []float64
+		for _, el := range strings.Split(s, ",") {
+			v, err := strconv.ParseFloat(strings.TrimSpace(el), 64)
+			if err != nil {
+				return nil, err
+			}
+			coordinates = append(coordinates, v)
+		}
+		switch len(coordinates) {
+		case 2:
+			return neo4j.Point2D{X: coordinates[0], Y: coordinates[1], SpatialRefId: 4326}, nil
+		case 3:
+			return neo4j.Point3D{X: coordinates[0], Y: coordinates[1], Z: coordinates[2], SpatialRefId: 4979}, nil
+		default:
+			return nil, fmt.Errorf("point shall have 2, or 3 coordinates, got %d", len(coordinates))
+		}
+	}
+
+	v, err := strconv.ParseInt(s, 10, 64)
+	switch {
+	case err == nil:
+		return v, nil
+	case errors.Is(err, strconv.ErrRange):
+		// The integer beyond int64 is kept intact instead of losing its precision as float.
+		return s, nil
+	}
+	if v, err := strconv.ParseFloat(s, 64); err == nil {
+		return v, nil
+	}
+	return s, nil
+}
+
+// formatProperty converts the property's value read from the database to string.
+// It's the inverse of convertProperty.
+func formatProperty(v any) string {
+	switch vv := v.(type) {
+	case float64:
+		// The default format uses the exponent for large numbers which doesn't match the configured value.
+		return strconv.FormatFloat(vv, 'f', -1, 64)
+	case []byte:
+		return base64.StdEncoding.EncodeToString(vv)
+	case time.Time:
+		return vv.Format(time.RFC3339Nano)
+	case neo4j.Point2D:
+		return strconv.FormatFloat(vv.X, 'f', -1, 64) + "," + strconv.FormatFloat(vv.Y, 'f', -1, 64)
+	case neo4j.Point3D:
+		return strconv.FormatFloat(vv.X, 'f', -1, 64) + "," + strconv.FormatFloat(vv.Y, 'f', -1, 64) + "," +
+			strconv.FormatFloat(vv.Z, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// readProperties converts the properties to the types set by propertyTypes.
+// The types of the properties not listed in propertyTypes are guessed unless coerceTypes is false.
+func readProperties(ctx context.Context, props types.Map, propertyTypes types.Map,
+	coerceTypes types.Bool) (o map[string]any, diags diag.Diagnostics) {
 	if !props.IsNull() && !props.IsUnknown() {
 		elements := make(map[string]types.String, len(props.Elements()))
 		if _, ok := elements["uuid"]; ok {
 			diags.AddError("reserved key is set as property", "uuid is reserved")
 		}
 		diags.Append(props.ElementsAs(ctx, &elements, false)...)
+		var typesByKey map[string]string
+		if !propertyTypes.IsNull() && !propertyTypes.IsUnknown() {
+			diags.Append(propertyTypes.ElementsAs(ctx, &typesByKey, false)...)
+		}
 		if !diags.HasError() {
 			o = make(map[string]any, len(elements))
 			for k, v := range elements {
@@ -80,14 +265,17 @@ func readProperties(ctx context.Context, props types.Map) (o map[string]any, dia
 					diags.AddError("key is unknown", k)
 				}
 
-				s := v.ValueString()
-				if vv, err := strconv.ParseInt(s, 10, 64); err == nil {
-					o[k] = vv
-				} else if vv, err := strconv.ParseFloat(s, 64); err == nil {
-					o[k] = vv
-				} else {
-					o[k] = s
+				propertyType, ok := typesByKey[k]
+				if !ok && !coerceTypes.IsNull() && !coerceTypes.ValueBool() {
+					propertyType = propertyTypeString
+				}
+				vv, err := convertProperty(v.ValueString(), propertyType)
+				if err != nil {
+					diags.AddAttributeError(path.Root("properties").AtMapKey(k), "faulty property value",
+						fmt.Sprintf("cannot convert to %s: %v", propertyType, err))
+					continue
 				}
+				o[k] = vv
 			}
 		}
 	} else {
@@ -101,6 +289,54 @@ func readProperties(ctx context.Context, props types.Map) (o map[string]any, dia
 
 const nodeSuffix = "_node"
 
+const (
+	nodeUpdateModeReplace = "replace"
+	nodeUpdateModeMerge   = "merge"
+)
+
+const (
+	nodeCreateQuery = `MERGE (n{uuid:$uuid})
+FOREACH (l in $labels | SET n:$(l))
+SET n += $properties
+`
+	nodeUpdateQuery = `MATCH (n{uuid:$uuid})
+FOREACH (l in labels(n) | REMOVE n:$(l)) 
+FOREACH (l in $labels | SET n:$(l))
+SET n = {}
+SET n += $properties, n.uuid = $uuid
+`
+	// nodeMergeQuery keeps the node's properties which are not configured, the null properties are removed.
+	nodeMergeQuery = `MATCH (n{uuid:$uuid})
+FOREACH (l in labels(n) | REMOVE n:$(l)) 
+FOREACH (l in $labels | SET n:$(l))
+SET n += $properties
+`
+	nodeVerifyQuery = `MATCH (n{uuid:$uuid}) RETURN properties(n)`
+	// nodeStoredQuery reads the node to compare it to the update.
+	nodeStoredQuery = `MATCH (n{uuid:$uuid}) RETURN properties(n), labels(n) AS labels`
+	nodeExistsQuery = `RETURN EXISTS { MATCH (n{uuid:$uuid}) }`
+	// nodeAdoptQuery sets the uuid to the single unmanaged node which matches the labels and the selector.
+	nodeAdoptQuery = `MATCH (n)
+WHERE n.uuid IS NULL
+  AND all(l IN $labels WHERE l IN labels(n))
+  AND all(k IN keys($selector) WHERE n[k] = $selector[k])
+WITH collect(n) AS nodes
+FOREACH (n IN CASE WHEN size(nodes) = 1 THEN nodes ELSE [] END | SET n.uuid = $uuid)
+RETURN size(nodes)
+`
+	// nodeReleaseQuery removes the uuid set to the adopted node.
+	nodeReleaseQuery = `MATCH (n{uuid:$uuid}) REMOVE n.uuid`
+	// nodeDuplicatesQuery finds the nodes other than the node with the uuid
+	// which have the labels and the natural key's properties.
+	nodeDuplicatesQuery = `MATCH (n)
+WHERE all(l IN $labels WHERE l IN labels(n))
+  AND all(k IN keys($key) WHERE n[k] = $key[k])
+  AND coalesce(n.uuid, '') <> $uuid
+RETURN coalesce(n.uuid, elementId(n))
+LIMIT 10
+`
+)
+
 func (r *NodeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + nodeSuffix
 }
@@ -111,10 +347,16 @@ func (r *NodeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"https://neo4j.com/docs/getting-started/appendix/graphdb-concepts/#graphdb-node",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Computed:            true,
-				MarkdownDescription: "Node unique identifier.",
+				MarkdownDescription: "Node unique identifier. " + externalIDDescription + " " +
+					"If `natural_key` is set, the identifier is derived from the `labels` and the natural key's " +
+					"properties when the creation is planned, hence the apply retried after the interruption " +
+					"writes onto the same node instead of creating the duplicate.",
+				Optional:   true,
+				Computed:   true,
+				Validators: externalIDValidators,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"labels": schema.ListAttribute{
@@ -129,6 +371,88 @@ func (r *NodeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"update_mode": schema.StringAttribute{
+				MarkdownDescription: "The mode to update the node's properties: `replace` to replace all properties " +
+					"with the configured ones, or `merge` to keep the properties which are not configured, " +
+					"e.g. those set by the application. In the `merge` mode, the property set to null is removed, " +
+					"e.g. `properties = { legacy_code = null }`, and only the configured properties are read back. " +
+					"Defaults to `replace`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(nodeUpdateModeReplace),
+				Validators: []validator.String{
+					stringvalidator.OneOf(nodeUpdateModeReplace, nodeUpdateModeMerge),
+				},
+			},
+			"property_types": schema.MapAttribute{
+				MarkdownDescription: propertyTypesDescription,
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators:          propertyTypesValidators,
+			},
+			"coerce_types": schema.BoolAttribute{
+				MarkdownDescription: coerceTypesDescription,
+				Optional:            true,
+			},
+			"verify_after_write": schema.BoolAttribute{
+				MarkdownDescription: verifyAfterWriteDescription,
+				Optional:            true,
+			},
+			"extra_labels_from": schema.ListAttribute{
+				MarkdownDescription: "The names of the provider's `label_sets` whose labels are added to `labels`, " +
+					"e.g. to add the labels shared by the taxonomy. The added labels are not read back to `labels`.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  []validator.List{listvalidator.UniqueValues()},
+			},
+			"execute_as_role": executeAsRoleAttribute,
+			"preconditions":   preconditionsAttribute,
+			"postconditions":  postconditionsAttribute,
+			"statements": schema.ListAttribute{
+				MarkdownDescription: statementsDescription,
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"adopt_if_exists": schema.BoolAttribute{
+				MarkdownDescription: "Set to adopt the existing node instead of creating a new one. " +
+					"The node is adopted if it's the single node which has the `labels` and " +
+					"the properties given by `adopt_selector`, and which is not managed by Terraform yet. " +
+					"The labels and properties of the adopted node are replaced by the configured ones.",
+				Optional: true,
+			},
+			"adopt_selector": schema.MapAttribute{
+				MarkdownDescription: "The properties to select the node to adopt by.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.AlsoRequires(path.MatchRoot("adopt_if_exists")),
+				},
+			},
+			"natural_key": schema.ListAttribute{
+				MarkdownDescription: "The keys of the `properties` which identify the node. " +
+					"If set, the node is not created when other node with the same `labels` " +
+					"and the natural key's properties exists. The node identifier is derived from them " +
+					"unless `id` is set.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"unique_properties": schema.ListAttribute{
+				MarkdownDescription: "The properties which are unique for the nodes with the first of `labels`. " +
+					"If set, the matching uniqueness constraint is created unless it exists. " +
+					"The constraint is kept on destroy, since other nodes may rely on it.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.AlsoRequires(path.MatchRoot("labels")),
+				},
+			},
 		},
 	}
 }
@@ -138,11 +462,11 @@ func (r *NodeResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	client, ok := req.ProviderData.(neo4j.SessionWithContext)
+	client, ok := req.ProviderData.(*Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected neo4j.DriverWithContext, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -150,6 +474,230 @@ func (r *NodeResource) Configure(_ context.Context, req resource.ConfigureReques
 	r.client = client
 }
 
+// externalIDDescription documents the externally supplied identifier.
+const externalIDDescription = "It's generated unless set, e.g. to keep the identifier minted by another system. " +
+	"The identifier shall consist of up to 128 letters, digits, and the characters `.`, `_`, `:`, `-`."
+
+// externalIDValidators validate the format of the externally supplied identifier.
+var externalIDValidators = []validator.String{
+	stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`),
+		"shall consist of up to 128 letters, digits, and the characters '.', '_', ':', '-'"),
+}
+
+// configuredID returns the identifier set in the configuration, it's empty if the identifier shall be generated.
+func configuredID(id types.String) string {
+	if id.IsNull() || id.IsUnknown() {
+		return ""
+	}
+	return id.ValueString()
+}
+
+// checkIDAvailable validates that no entity has the externally supplied identifier yet,
+// because the create statements would merge onto it.
+func checkIDAvailable(ctx context.Context, client *Client, entity, id, existsQuery string) (diags diag.Diagnostics) {
+	dbResp, err := client.Run(ctx, existsQuery, map[string]any{"uuid": id})
+	if err != nil {
+		diags.AddError("failed to check the "+entity+" identifier", err.Error())
+		return diags
+	}
+	rec, err := dbResp.Single(ctx)
+	if err != nil {
+		diags.AddError("failed to check the "+entity+" identifier", err.Error())
+		return diags
+	}
+	if exists, _ := rec.Values[0].(bool); exists {
+		diags.AddAttributeError(path.Root("id"), "duplicated "+entity+" identifier",
+			fmt.Sprintf("The %s %s exists already, import it to bring it under Terraform management.", entity, id))
+	}
+	return diags
+}
+
+// verifyAfterWriteDescription documents the attribute to opt-in the read-your-writes verification.
+const verifyAfterWriteDescription = "Set to re-read the entity right after it's created, or updated, " +
+	"and to fail if the written properties are not visible, e.g. because the statement matched nothing."
+
+// verifyWrite re-reads the entity's properties with the client which wrote them, i.e. in the session
+// which shares the write's bookmarks, hence the read follows the write, and compares them to the written
+// properties. The properties which were not written are ignored if they are merged,
+// and the nil properties shall be removed.
+func verifyWrite(ctx context.Context, client *Client, entity, id, query string,
+	properties map[string]any, merged bool) (diags diag.Diagnostics) {
+	dbResp, err := client.Run(ctx, query, map[string]any{"uuid": id})
+	if err != nil {
+		diags.AddError("failed to verify the "+entity, err.Error())
+		return diags
+	}
+	var rec *neo4j.Record
+	if !dbResp.NextRecord(ctx, &rec) {
+		if err := dbResp.Err(); err != nil {
+			diags.AddError("failed to verify the "+entity, err.Error())
+			return diags
+		}
+		diags.AddError(entity+" not written",
+			fmt.Sprintf("The %s %s is not found after the write.", entity, id))
+		return diags
+	}
+
+	written, _ := rec.Values[0].(map[string]any)
+	if mismatched := mismatchedProperties(written, properties, merged); len(mismatched) > 0 {
+		diags.AddError(entity+" not written",
+			fmt.Sprintf("The properties of the %s %s differ from the written ones after the write: %s",
+				entity, id, strings.Join(mismatched, ", ")))
+	}
+	return diags
+}
+
+// mismatchedProperties lists the sorted keys of the stored properties which differ from the written ones.
+// The properties which are not written are ignored if they are merged, and the nil properties shall be removed.
+func mismatchedProperties(stored, properties map[string]any, merged bool) (mismatched []string) {
+	for k, v := range properties {
+		w, ok := stored[k]
+		if (v == nil && ok) || (v != nil && (!ok || formatProperty(w) != formatProperty(v))) {
+			mismatched = append(mismatched, k)
+		}
+	}
+	for k := range stored {
+		if _, ok := properties[k]; !ok && !merged && k != "uuid" {
+			mismatched = append(mismatched, k)
+		}
+	}
+	slices.Sort(mismatched)
+	return mismatched
+}
+
+// isStored checks if the entity has the properties and the labels to write already, before it's updated,
+// because the update statements report the properties set to the same values as the changes.
+// The labels are not compared if they are nil, and it's false if the entity is not found.
+func isStored(ctx context.Context, client *Client, query, id string, labels []string,
+	properties map[string]any, merged bool) (bool, error) {
+	dbResp, err := client.Run(ctx, query, map[string]any{"uuid": id})
+	if err != nil {
+		return false, err
+	}
+	records, err := dbResp.Collect(ctx)
+	if err != nil || len(records) == 0 {
+		return false, err
+	}
+
+	stored, _ := records[0].Values[0].(map[string]any)
+	if len(mismatchedProperties(stored, properties, merged)) > 0 {
+		return false, nil
+	}
+	if labels == nil {
+		return true, nil
+	}
+	var storedLabels []string
+	if v, ok := records[0].Get("labels"); ok {
+		values, _ := v.([]any)
+		for _, l := range values {
+			storedLabels = append(storedLabels, fmt.Sprintf("%v", l))
+		}
+	}
+	return sameElements(labels, storedLabels), nil
+}
+
+// statementsDescription documents the computed attribute to preview the Cypher statements.
+const statementsDescription = "The Cypher statements run for the last change. " +
+	"They are shown in the plan to preview the pending change."
+
+// planStatements sets the Cypher statements to be run for the pending creation, or update to the plan.
+func planStatements(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+	createQuery, updateQuery string) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var statements types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("statements"), &statements)...)
+	// The statements are known when the resource is not changed.
+	if resp.Diagnostics.HasError() || !statements.IsUnknown() {
+		return
+	}
+
+	query := updateQuery
+	if req.State.Raw.IsNull() {
+		query = createQuery
+	}
+	statements, diags := types.ListValueFrom(ctx, types.StringType, []string{query})
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("statements"), statements)...)
+}
+
+func (r *NodeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse) {
+	var updateMode types.String
+	if !req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("update_mode"), &updateMode)...)
+	}
+	planStatements(ctx, req, resp, nodeCreateQuery, NodeResourceModel{UpdateMode: updateMode}.updateQuery())
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	var configured types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &configured)...)
+	if resp.Diagnostics.HasError() || !configured.IsNull() {
+		return
+	}
+
+	var data NodeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id, diags := naturalKeyID(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || id == "" {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// naturalKeyNamespace is the namespace of the node identifiers derived from the natural key.
+var naturalKeyNamespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("https://registry.terraform.io/providers/kislerdm/neo4j/node"))
+
+// naturalKeyID derives the identifier of the node to create from its labels and the natural key's properties,
+// hence every plan, and every retried apply of the creation define the same identifier.
+// It's empty unless the natural key is set, and its properties are known.
+func naturalKeyID(ctx context.Context, data NodeResourceModel) (string, diag.Diagnostics) {
+	if data.NaturalKey.IsNull() || data.NaturalKey.IsUnknown() || data.Labels.IsUnknown() ||
+		data.Properties.IsNull() || data.Properties.IsUnknown() {
+		return "", nil
+	}
+	for _, v := range append(data.NaturalKey.Elements(), data.Labels.Elements()...) {
+		if v.IsUnknown() {
+			return "", nil
+		}
+	}
+
+	var keys []string
+	diags := data.NaturalKey.ElementsAs(ctx, &keys, false)
+	labels, d := data.ReadLabels(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	properties := data.Properties.Elements()
+	var key = make(map[string]string, len(keys))
+	for _, k := range keys {
+		v, ok := properties[k].(types.String)
+		// The missing natural key's property is reported when the node is created.
+		if !ok || v.IsNull() || v.IsUnknown() {
+			return "", diags
+		}
+		key[k] = v.ValueString()
+	}
+
+	slices.Sort(labels)
+	v, err := json.Marshal(map[string]any{"labels": labels, "key": key})
+	if err != nil {
+		diags.AddError("failed to derive the node identifier", err.Error())
+		return "", diags
+	}
+	return uuid.NewSHA1(naturalKeyNamespace, v).String(), diags
+}
+
 func (r *NodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data NodeResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -157,37 +705,251 @@ func (r *NodeResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+nodeSuffix, auditOperationCreate, &data.ID)
+	defer r.client.notify(ctx, &resp.Diagnostics, Name+nodeSuffix, auditOperationCreate, &data.ID)
+
+	client, diags := r.client.asRole(ctx, data.ExecuteAsRole)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "create a node")
-	id := uuid.NewString()
+	var configured types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &configured)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The identifier is known unless it's configured, or derived from the natural key when planned.
+	id := configuredID(data.ID)
+	if id != "" && !configured.IsNull() {
+		resp.Diagnostics.Append(checkIDAvailable(ctx, r.client, "node", id, nodeExistsQuery)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if id == "" {
+		var err error
+		if id, err = r.client.newID(); err != nil {
+			resp.Diagnostics.AddError("failed to generate the node identifier", err.Error())
+			return
+		}
+	}
 
-	labels, diags := data.ReadLabels(ctx)
+	labels, diags := r.readLabels(ctx, data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		tflog.Debug(ctx, "faulty labels provided")
 		return
 	}
 
-	properties, diags := readProperties(ctx, data.Properties)
+	properties, diags := data.ReadProperties(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		tflog.Debug(ctx, "faulty properties provided")
 		return
 	}
 
-	if _, err := r.client.Run(ctx, `MERGE (n{uuid:$uuid})
-FOREACH (l in $labels | SET n:$(l))
-SET n += $properties
-`, map[string]any{"uuid": id, "labels": labels, "properties": properties}); err != nil {
-		tflog.Debug(ctx, "failed to create the node")
-		resp.Diagnostics.AddError("failed to create the node", err.Error())
+	resp.Diagnostics.Append(r.client.checkOwnsNewNode(labels, properties)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkPreconditions(ctx, data.Preconditions, id, properties)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := nodeCreateQuery
+	if data.AdoptIfExists.ValueBool() {
+		adopted, diags := r.adopt(ctx, client, id, labels, data.AdoptSelector, data.PropertyTypes, data.CoerceTypes)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			tflog.Debug(ctx, "failed to adopt the node")
+			return
+		}
+		if adopted {
+			tflog.Debug(ctx, "adopted the existing node", map[string]interface{}{"uuid": id})
+			query = data.updateQuery()
+			// The uuid is set to the node before it's written, hence the uuid is removed if the node
+			// is not stored in the state, otherwise the node could never be adopted again.
+			defer func() {
+				if resp.Diagnostics.HasError() {
+					r.release(ctx, client, id, &resp.Diagnostics)
+				}
+			}()
+
+			// The node is matched by the selector, hence it can be outside the ownership boundary.
+			resp.Diagnostics.Append(r.client.checkOwned(ctx, entityTypeNode, id)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(r.declareUniqueConstraint(ctx, labels, data.UniqueProperties)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if query == nodeCreateQuery && !data.NaturalKey.IsNull() {
+		resp.Diagnostics.Append(r.checkDuplicates(ctx, id, labels, properties, data.NaturalKey)...)
+		if resp.Diagnostics.HasError() {
+			tflog.Debug(ctx, "the node is duplicated")
+			return
+		}
+	}
+
+	if _, err := client.runWrite(ctx, query, map[string]any{"uuid": id, "labels": labels, "properties": properties},
+		data.Postconditions, id, properties); err != nil {
+		r.client.addOperationError(ctx, &resp.Diagnostics, diagnosticOperationCreate, "node", id, err)
+		return
+	}
+	if data.VerifyAfterWrite.ValueBool() {
+		resp.Diagnostics.Append(verifyWrite(ctx, client, "node", id, nodeVerifyQuery, properties, data.isMerged())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	data.ID = types.StringValue(id)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	tflog.Trace(ctx, "created a node")
 }
 
+// adopt sets the uuid to the existing node selected by the labels and the selector's properties.
+// It returns false if no node matches.
+func (r *NodeResource) adopt(ctx context.Context, client *Client, id string, labels []string,
+	selector, propertyTypes types.Map, coerceTypes types.Bool) (adopted bool, diags diag.Diagnostics) {
+	if selector.IsNull() {
+		diags.AddAttributeError(path.Root("adopt_selector"), "missing selector",
+			"adopt_selector shall be set to adopt the existing node")
+		return false, diags
+	}
+	properties, diags := readProperties(ctx, selector, propertyTypes, coerceTypes)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	dbResp, err := client.Run(ctx, nodeAdoptQuery, map[string]any{
+		"uuid":     id,
+		"labels":   labels,
+		"selector": properties,
+	})
+	if err != nil {
+		diags.AddError("failed to adopt the node", err.Error())
+		return false, diags
+	}
+	rec, err := dbResp.Single(ctx)
+	if err != nil {
+		diags.AddError("failed to adopt the node", err.Error())
+		return false, diags
+	}
+
+	switch found, _ := rec.Values[0].(int64); {
+	case found > 1:
+		diags.AddAttributeError(path.Root("adopt_selector"), "ambiguous selector",
+			fmt.Sprintf("%d nodes match the selector, the node to adopt cannot be defined", found))
+	case found == 1:
+		adopted = true
+	}
+	return adopted, diags
+}
+
+// release removes the uuid set to the adopted node, hence the node can be adopted by the next apply.
+func (r *NodeResource) release(ctx context.Context, client *Client, id string, diags *diag.Diagnostics) {
+	ctx, cancel := cleanupContext(ctx)
+	defer cancel()
+
+	dbResp, err := client.Run(ctx, nodeReleaseQuery, map[string]any{"uuid": id})
+	if err == nil {
+		_, err = dbResp.Consume(ctx)
+	}
+	if err != nil {
+		diags.AddWarning("failed to release the adopted node",
+			fmt.Sprintf("The uuid %s remains set to the node which is not managed by Terraform, "+
+				"remove it to adopt the node again: %v", id, err))
+	}
+}
+
+// checkDuplicates verifies that no other node has the same labels and the natural key's properties.
+// The node with the same uuid is not the duplicate, it's written by the interrupted apply of the same creation.
+func (r *NodeResource) checkDuplicates(ctx context.Context, id string, labels []string, properties map[string]any,
+	naturalKey types.List) (diags diag.Diagnostics) {
+	var keys []string
+	diags.Append(naturalKey.ElementsAs(ctx, &keys, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var key = make(map[string]any, len(keys))
+	for _, k := range keys {
+		v, ok := properties[k]
+		if !ok {
+			diags.AddAttributeError(path.Root("natural_key"), "unknown natural key",
+				fmt.Sprintf("the property %s is not set", k))
+			return diags
+		}
+		key[k] = v
+	}
+
+	dbResp, err := r.client.Run(ctx, nodeDuplicatesQuery, map[string]any{"uuid": id, "labels": labels, "key": key})
+	if err != nil {
+		diags.AddError("failed to check the duplicated nodes", err.Error())
+		return diags
+	}
+	var ids []string
+	var rec *neo4j.Record
+	for dbResp.NextRecord(ctx, &rec) {
+		ids = append(ids, fmt.Sprintf("%v", rec.Values[0]))
+	}
+	if err := dbResp.Err(); err != nil {
+		diags.AddError("failed to check the duplicated nodes", err.Error())
+		return diags
+	}
+
+	if len(ids) > 0 {
+		diags.AddAttributeError(path.Root("natural_key"), "duplicated node",
+			fmt.Sprintf("the nodes with the same natural key exist: %s", strings.Join(ids, ", ")))
+	}
+	return diags
+}
+
+// uniqueConstraintQuery defines the command to create the uniqueness constraint.
+// The label and the properties cannot be passed as parameters to the constraint commands,
+// hence they are escaped and embedded into the query.
+func uniqueConstraintQuery(label string, properties []string) string {
+	var props = make([]string, len(properties))
+	for i, p := range properties {
+		props[i] = "n." + quoteName(p)
+	}
+	return "CREATE CONSTRAINT IF NOT EXISTS FOR (n:" + quoteName(label) + ") REQUIRE (" +
+		strings.Join(props, ", ") + ") IS UNIQUE"
+}
+
+// declareUniqueConstraint creates the uniqueness constraint for the node's first label unless it exists.
+func (r *NodeResource) declareUniqueConstraint(ctx context.Context, labels []string,
+	uniqueProperties types.List) (diags diag.Diagnostics) {
+	if uniqueProperties.IsNull() || len(labels) == 0 {
+		return diags
+	}
+	var properties []string
+	diags.Append(uniqueProperties.ElementsAs(ctx, &properties, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	if _, err := r.client.Run(ctx, uniqueConstraintQuery(labels[0], properties), nil); err != nil {
+		diags.AddAttributeError(path.Root("unique_properties"), "failed to create the uniqueness constraint",
+			err.Error())
+	}
+	return diags
+}
+
 func (r *NodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data NodeResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -211,34 +973,77 @@ func (r *NodeResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+nodeSuffix, auditOperationUpdate, &data.ID)
+	defer r.client.notify(ctx, &resp.Diagnostics, Name+nodeSuffix, auditOperationUpdate, &data.ID)
+
+	client, diags := r.client.asRole(ctx, data.ExecuteAsRole)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := data.ID.ValueString()
 	tflog.Trace(ctx, "updating the node", map[string]interface{}{"id": id})
 
-	labels, diags := data.ReadLabels(ctx)
+	labels, diags := r.readLabels(ctx, data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		tflog.Debug(ctx, "faulty labels provided")
 		return
 	}
 
-	properties, diags := readProperties(ctx, data.Properties)
+	properties, diags := data.ReadProperties(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		tflog.Debug(ctx, "faulty properties provided")
 		return
 	}
 
-	if _, err := r.client.Run(ctx, `MATCH (n{uuid:$uuid})
-FOREACH (l in labels(n) | REMOVE n:$(l)) 
-FOREACH (l in $labels | SET n:$(l))
-SET n = {}
-SET n += $properties, n.uuid = $uuid
-`, map[string]any{"uuid": id, "labels": labels, "properties": properties}); err != nil {
-		tflog.Debug(ctx, "failed to update the node")
-		resp.Diagnostics.AddError("failed to update the node", err.Error())
+	resp.Diagnostics.Append(r.client.checkOwned(ctx, entityTypeNode, id)...)
+	resp.Diagnostics.Append(r.client.checkOwnsNewNode(labels, properties)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.checkPreconditions(ctx, data.Preconditions, id, properties)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.declareUniqueConstraint(ctx, labels, data.UniqueProperties)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stored, err := isStored(ctx, r.client, nodeStoredQuery, id, labels, properties, data.isMerged())
+	if err != nil {
+		r.client.addOperationError(ctx, &resp.Diagnostics, diagnosticOperationUpdate, "node", id, err)
 		return
 	}
 
+	summary, err := client.runWrite(ctx, data.updateQuery(),
+		map[string]any{"uuid": id, "labels": labels, "properties": properties}, data.Postconditions, id, properties)
+	if err != nil {
+		r.client.addOperationError(ctx, &resp.Diagnostics, diagnosticOperationUpdate, "node", id, err)
+		return
+	}
+	resp.Diagnostics.Append(checkUpdated(ctx, r.client, summary, stored, "node", id, nodeExistsQuery)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.VerifyAfterWrite.ValueBool() {
+		resp.Diagnostics.Append(verifyWrite(ctx, client, "node", id, nodeVerifyQuery, properties, data.isMerged())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if !resp.Diagnostics.HasError() {
 		tflog.Trace(ctx, "failed to update state")
@@ -253,13 +1058,35 @@ func (r *NodeResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The identifier is reset once the node is deleted.
+	id := data.ID
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+nodeSuffix, auditOperationDelete, &id)
+	defer r.client.notify(ctx, &resp.Diagnostics, Name+nodeSuffix, auditOperationDelete, &id)
+
+	client, diags := r.client.asRole(ctx, data.ExecuteAsRole)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "delete the node")
-	if _, err := r.client.Run(ctx,
+	resp.Diagnostics.Append(r.client.checkOwned(ctx, entityTypeNode, data.ID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := client.Run(ctx,
 		`MATCH (n{uuid:$uuid}) DETACH DELETE n`,
 		map[string]any{"uuid": data.ID.ValueString()},
 	); err != nil {
-		tflog.Debug(ctx, "failed to delete the node")
-		resp.Diagnostics.AddError("failed to delete the node", err.Error())
+		r.client.addOperationError(ctx, &resp.Diagnostics, diagnosticOperationDelete, "node", data.ID.ValueString(),
+			err)
 		return
 	}
 	data.ID = types.StringNull()
@@ -271,8 +1098,16 @@ func (r *NodeResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 func (r *NodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse) {
+	id, err := r.client.importID(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("unexpected import identifier", err.Error())
+		return
+	}
+
 	var data NodeResourceModel
-	data.ID = basetypes.NewStringValue(req.ID)
+	data.ID = basetypes.NewStringValue(id)
+	data.Statements = types.ListNull(types.StringType)
+	data.UpdateMode = types.StringValue(nodeUpdateModeReplace)
 	tflog.Trace(ctx, "importing the node", map[string]interface{}{"id": req.ID})
 	resp.Diagnostics.Append(r.read(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -287,6 +1122,114 @@ func (r *NodeResource) ImportState(ctx context.Context, req resource.ImportState
 	tflog.Trace(ctx, "imported the node", map[string]interface{}{"id": req.ID})
 }
 
+// checkUpdated uses the result summary to distinguish the update which did not match the entity,
+// e.g. because it was deleted concurrently, from the update which matched it, but changed nothing
+// because the entity was stored as planned already.
+// The existsQuery is run to check if the entity exists when no updates are reported.
+func checkUpdated(ctx context.Context, client *Client, summary neo4j.ResultSummary, stored bool,
+	entity, id, existsQuery string) (diags diag.Diagnostics) {
+	if summary.Counters().ContainsUpdates() {
+		if stored {
+			diags.Append(noChangesWarning(entity, id))
+		}
+		return diags
+	}
+
+	existsResp, err := client.Run(ctx, existsQuery, map[string]any{"uuid": id})
+	if err != nil {
+		diags.AddError("failed to update the "+entity, err.Error())
+		return diags
+	}
+	rec, err := existsResp.Single(ctx)
+	if err != nil {
+		diags.AddError("failed to update the "+entity, err.Error())
+		return diags
+	}
+	if exists, _ := rec.Values[0].(bool); exists {
+		diags.Append(noChangesWarning(entity, id))
+		return diags
+	}
+	diags.AddError(entity+" not found",
+		fmt.Sprintf("The %s %s was not found, it may have been deleted outside of Terraform. "+
+			"Refresh the state to plan its creation.", entity, id))
+	return diags
+}
+
+// noChangesWarning reports the update which changed nothing.
+func noChangesWarning(entity, id string) diag.Diagnostic {
+	return diag.NewWarningDiagnostic("no changes made to the "+entity,
+		fmt.Sprintf("The %s %s matched the planned state already, hence nothing was changed.", entity, id))
+}
+
+// sameElements checks if both slices contain the same elements regardless of their order.
+func sameElements(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+// readMergedProperties reads back the configured properties of the node updated in the merge mode.
+// The property which is missing is dropped to detect the drift, unless it's set to null to remove it.
+func readMergedProperties(ctx context.Context, data *NodeResourceModel,
+	properties map[string]any) (diags diag.Diagnostics) {
+	if data.Properties.IsNull() {
+		return diags
+	}
+	var o = map[string]attr.Value{}
+	for k, v := range data.Properties.Elements() {
+		switch current, ok := properties[k]; {
+		case ok:
+			o[k] = types.StringValue(formatProperty(current))
+		case v.IsNull():
+			o[k] = types.StringNull()
+		}
+	}
+	data.Properties, diags = types.MapValue(types.StringType, o)
+	return diags
+}
+
+// extraLabels reads the labels of the provider's label sets the node's labels are extended by.
+func (r *NodeResource) extraLabels(ctx context.Context, data NodeResourceModel) (o []string, diags diag.Diagnostics) {
+	if data.ExtraLabelsFrom.IsNull() || data.ExtraLabelsFrom.IsUnknown() {
+		return nil, diags
+	}
+	var names []string
+	diags.Append(data.ExtraLabelsFrom.ElementsAs(ctx, &names, false)...)
+	for _, name := range names {
+		labels, ok := r.client.labelSets[name]
+		if !ok {
+			diags.AddAttributeError(path.Root("extra_labels_from"), "unknown label set",
+				fmt.Sprintf("the label set %s is not defined in the provider's label_sets", name))
+			continue
+		}
+		o = append(o, labels...)
+	}
+	return o, diags
+}
+
+// readLabels reads the configured labels extended by the labels of the provider's label sets.
+func (r *NodeResource) readLabels(ctx context.Context, data NodeResourceModel) ([]string, diag.Diagnostics) {
+	labels, diags := data.ReadLabels(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+	extra, d := r.extraLabels(ctx, data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	for _, l := range extra {
+		if !slices.Contains(labels, l) {
+			labels = append(labels, l)
+		}
+	}
+	return labels, diags
+}
+
 func (r *NodeResource) read(ctx context.Context, data *NodeResourceModel) (diags diag.Diagnostics) {
 	id := data.ID.ValueString()
 	if data.Labels.IsNull() || data.Labels.IsUnknown() {
@@ -295,29 +1238,40 @@ func (r *NodeResource) read(ctx context.Context, data *NodeResourceModel) (diags
 	if data.Properties.IsNull() || data.Properties.IsUnknown() {
 		data.Properties = types.MapNull(types.StringType)
 	}
-	dbResp, err := r.client.Run(ctx, `MATCH (n{uuid:$uuid}) RETURN n`, map[string]any{"uuid": id})
+	// The reads are coalesced with the concurrent reads of other nodes to refresh many resources at once.
+	v, found, err := r.client.nodes.get(ctx, id)
 	switch err != nil {
 	case true:
-		diags.AddError("failed to read the node", err.Error())
+		r.client.addOperationError(ctx, &diags, diagnosticOperationRead, "node", id, err)
 	default:
-		var rec *neo4j.Record
-		if dbResp.NextRecord(ctx, &rec) {
-			node := rec.Values[0].(neo4j.Node)
+		node, ok := v.(neo4j.Node)
+		if found && ok {
 
 			var d diag.Diagnostics
-			if !(data.Labels.IsNull() && len(node.Labels) == 0) {
-				data.Labels, d = types.ListValueFrom(ctx, types.StringType, node.Labels)
+			// The labels are returned in the order of their internal ids, the configured order is kept.
+			// The labels added from the label sets are excluded unless they're configured as well.
+			configured, _ := data.ReadLabels(ctx)
+			current, _ := r.readLabels(ctx, *data)
+			if !(data.Labels.IsNull() && len(node.Labels) == 0) && !sameElements(current, node.Labels) {
+				extra, _ := r.extraLabels(ctx, *data)
+				labels := slices.DeleteFunc(slices.Clone(node.Labels), func(l string) bool {
+					return slices.Contains(extra, l) && !slices.Contains(configured, l)
+				})
+				data.Labels, d = types.ListValueFrom(ctx, types.StringType, labels)
 				diags.Append(d...)
 			}
 
-			if len(node.GetProperties()) > 1 {
+			if data.isMerged() {
+				// Only the configured properties are read back, the properties set to null shall be absent.
+				diags.Append(readMergedProperties(ctx, data, node.GetProperties())...)
+			} else if len(node.GetProperties()) > 1 {
 				var tmp = make(map[string]string, len(node.GetProperties())-1)
 				for k, v := range node.GetProperties() {
 					// Exclude the system property used to store the resource id.
 					// It's used because the private Neo4j identifier (elementId) may not be reliable
 					// beyond the scope of a single database transaction.
 					if k != "uuid" {
-						tmp[k] = fmt.Sprintf("%v", v)
+						tmp[k] = formatProperty(v)
 					}
 				}
 				if !(data.Properties.IsNull() && len(tmp) == 0) {
@@ -10,20 +10,271 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"reflect"
 	"regexp"
 	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 	"github.com/stretchr/testify/assert"
 )
 
+func TestConvertProperty(t *testing.T) {
+	tests := map[string]struct {
+		value        string
+		propertyType string
+		want         any
+		wantErr      bool
+	}{
+		"guessed int":       {value: "01234", want: int64(1234)},
+		"guessed float":     {value: "1.5", want: 1.5},
+		"guessed string":    {value: "foo", want: "foo"},
+		"string":            {value: "01234", propertyType: propertyTypeString, want: "01234"},
+		"int":               {value: "7", propertyType: propertyTypeInt, want: int64(7)},
+		"float":             {value: "7", propertyType: propertyTypeFloat, want: float64(7)},
+		"bool":              {value: "true", propertyType: propertyTypeBool, want: true},
+		"faulty bool":       {value: "yes", propertyType: propertyTypeBool, wantErr: true},
+		"datetime":          {value: "2024-01-02T03:04:05Z", propertyType: propertyTypeDatetime, want: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		"point 2d":          {value: "13.4,52.5", propertyType: propertyTypePoint, want: neo4j.Point2D{X: 13.4, Y: 52.5, SpatialRefId: 4326}},
+		"point 3d":          {value: "13.4, 52.5, 34", propertyType: propertyTypePoint, want: neo4j.Point3D{X: 13.4, Y: 52.5, Z: 34, SpatialRefId: 4979}},
+		"faulty point":      {value: "13.4", propertyType: propertyTypePoint, wantErr: true},
+		"faulty datetime":   {value: "2024-01-02", propertyType: propertyTypeDatetime, wantErr: true},
+		"faulty int":        {value: "1.5", propertyType: propertyTypeInt, wantErr: true},
+		"string with comma": {value: "1,5", want: "1,5"},
+		"max int64":         {value: "9223372036854775807", want: int64(9223372036854775807)},
+		"beyond int64":      {value: "9223372036854775808", want: "9223372036854775808"},
+		"bytes":             {value: "AAEC/w==", propertyType: propertyTypeBytes, want: []byte{0, 1, 2, 255}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertProperty(tt.value, tt.propertyType)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr {
+				return
+			}
+			if want, ok := tt.want.(time.Time); ok {
+				if !want.Equal(got.(time.Time)) {
+					t.Errorf("got %v, want %v", got, tt.want)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if tt.propertyType != "" && formatProperty(got) != strings.ReplaceAll(tt.value, " ", "") {
+				t.Errorf("formatted value %s does not match %s", formatProperty(got), tt.value)
+			}
+		})
+	}
+}
+
+func TestFormatProperty(t *testing.T) {
+	assert.Equal(t, "9223372036854775807", formatProperty(int64(9223372036854775807)))
+	assert.Equal(t, "123456789.5", formatProperty(123456789.5))
+	assert.Equal(t, "1.2", formatProperty(1.2))
+	assert.Equal(t, "AAEC/w==", formatProperty([]byte{0, 1, 2, 255}))
+}
+
+func TestSameElements(t *testing.T) {
+	assert.True(t, sameElements([]string{"b", "a"}, []string{"a", "b"}))
+	assert.True(t, sameElements(nil, []string{}))
+	assert.False(t, sameElements([]string{"a"}, []string{"a", "b"}))
+	assert.False(t, sameElements([]string{"a", "a"}, []string{"a", "b"}))
+}
+
+func TestMismatchedProperties(t *testing.T) {
+	stored := map[string]any{"uuid": "foo", "name": "Alice", "age": int64(42), "extra": true}
+	assert.Empty(t, mismatchedProperties(stored, map[string]any{"name": "Alice", "age": int64(42)}, true))
+	assert.Equal(t, []string{"extra"},
+		mismatchedProperties(stored, map[string]any{"name": "Alice", "age": int64(42)}, false))
+	assert.Equal(t, []string{"age", "extra"},
+		mismatchedProperties(stored, map[string]any{"name": "Alice", "age": int64(43), "extra": nil}, true))
+}
+
+// updateSummary mocks the summary of the update statement.
+type updateSummary struct {
+	neo4j.ResultSummary
+	updates bool
+}
+
+func (s updateSummary) Counters() neo4j.Counters {
+	return updateCounters{updates: s.updates}
+}
+
+type updateCounters struct {
+	neo4j.Counters
+	updates bool
+}
+
+func (c updateCounters) ContainsUpdates() bool {
+	return c.updates
+}
+
+func TestCheckUpdated(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("changed", func(t *testing.T) {
+		diags := checkUpdated(ctx, nil, updateSummary{updates: true}, false, "node", "foo", nodeExistsQuery)
+		assert.Empty(t, diags)
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		// the update statement sets the same values, hence the updates are reported
+		diags := checkUpdated(ctx, nil, updateSummary{updates: true}, true, "node", "foo", nodeExistsQuery)
+		assert.False(t, diags.HasError())
+		if assert.Len(t, diags, 1) {
+			assert.Equal(t, "no changes made to the node", diags[0].Summary())
+		}
+	})
+}
+
+func TestIsStored(t *testing.T) {
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err != nil {
+		t.Fatalf("could not connect to database: %v", err)
+	}
+	t.Cleanup(func() {
+		_, _ = c.Run(ctx, `MATCH (n{uuid:"test-is-stored"}) DELETE n`, nil)
+		_ = c.Close(ctx)
+	})
+	if _, err := c.Run(ctx, `CREATE (:Stored:Tested{uuid:"test-is-stored", name:"Alice"})`, nil); err != nil {
+		t.Fatalf("could not seed the graph: %v", err)
+	}
+
+	tests := map[string]struct {
+		id         string
+		labels     []string
+		properties map[string]any
+		want       bool
+	}{
+		"unchanged":           {"test-is-stored", []string{"Tested", "Stored"}, map[string]any{"name": "Alice"}, true},
+		"changed property":    {"test-is-stored", []string{"Stored", "Tested"}, map[string]any{"name": "Bob"}, false},
+		"changed labels":      {"test-is-stored", []string{"Stored"}, map[string]any{"name": "Alice"}, false},
+		"labels not compared": {"test-is-stored", nil, map[string]any{"name": "Alice"}, true},
+		"not found":           {"missing", []string{"Stored"}, map[string]any{"name": "Alice"}, false},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := isStored(ctx, c, nodeStoredQuery, tt.id, tt.labels, tt.properties, false)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUniqueConstraintQuery(t *testing.T) {
+	assert.Equal(t, "CREATE CONSTRAINT IF NOT EXISTS FOR (n:`Person`) REQUIRE (n.`email`) IS UNIQUE",
+		uniqueConstraintQuery("Person", []string{"email"}))
+	assert.Equal(t, "CREATE CONSTRAINT IF NOT EXISTS FOR (n:`Bank Account`) REQUIRE (n.`iban`, n.`bic`) IS UNIQUE",
+		uniqueConstraintQuery("Bank Account", []string{"iban", "bic"}))
+}
+
+func TestNodeModifyPlanNaturalKeyID(t *testing.T) {
+	ctx := context.Background()
+	var schemaResp fwresource.SchemaResponse
+	(&NodeResource{}).Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	// modifyPlan plans the creation of the node, and returns its planned identifier.
+	modifyPlan := func(t *testing.T, naturalKey []string, properties map[string]string) types.String {
+		var values = make(map[string]tftypes.Value, len(objType.AttributeTypes))
+		for k, v := range objType.AttributeTypes {
+			values[k] = tftypes.NewValue(v, nil)
+		}
+		values["labels"] = tftypes.NewValue(tftypes.List{ElementType: tftypes.String},
+			[]tftypes.Value{tftypes.NewValue(tftypes.String, "Country")})
+		props := make(map[string]tftypes.Value, len(properties))
+		for k, v := range properties {
+			props[k] = tftypes.NewValue(tftypes.String, v)
+		}
+		values["properties"] = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, props)
+		if naturalKey != nil {
+			keys := make([]tftypes.Value, len(naturalKey))
+			for i, k := range naturalKey {
+				keys[i] = tftypes.NewValue(tftypes.String, k)
+			}
+			values["natural_key"] = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, keys)
+		}
+		config := tftypes.NewValue(objType, maps.Clone(values))
+		values["id"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+		values["statements"] = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue)
+
+		req := fwresource.ModifyPlanRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config},
+			Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objType, values)},
+			State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objType, nil)},
+		}
+		resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+		(&NodeResource{client: &Client{}}).ModifyPlan(ctx, req, resp)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var id types.String
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("id"), &id)...)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		return id
+	}
+
+	t.Run("stable", func(t *testing.T) {
+		// the apply plans the creation again, hence both plans shall define the same identifier
+		id := modifyPlan(t, []string{"code"}, map[string]string{"code": "DE", "name": "Germany"})
+		assert.False(t, id.IsUnknown())
+		replanned := modifyPlan(t, []string{"code"}, map[string]string{"code": "DE", "name": "Deutschland"})
+		assert.Equal(t, id, replanned)
+
+		other := modifyPlan(t, []string{"code"}, map[string]string{"code": "FR", "name": "France"})
+		assert.NotEqual(t, id, other)
+	})
+
+	t.Run("without natural key", func(t *testing.T) {
+		assert.True(t, modifyPlan(t, nil, map[string]string{"code": "DE"}).IsUnknown())
+	})
+
+	t.Run("missing natural key's property", func(t *testing.T) {
+		assert.True(t, modifyPlan(t, []string{"code"}, map[string]string{"name": "Germany"}).IsUnknown())
+	})
+}
+
+func TestNodeReadLabels(t *testing.T) {
+	ctx := context.Background()
+	r := &NodeResource{client: &Client{labelSets: map[string][]string{
+		"asset":   {"Asset", "Tracked"},
+		"billing": {"Billable"},
+	}}}
+
+	data := NodeResourceModel{
+		Labels:          types.ListValueMust(types.StringType, []attr.Value{types.StringValue("Server"), types.StringValue("Tracked")}),
+		ExtraLabelsFrom: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("asset"), types.StringValue("billing")}),
+	}
+	labels, diags := r.readLabels(ctx, data)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []string{"Server", "Tracked", "Asset", "Billable"}, labels)
+
+	data.ExtraLabelsFrom = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("unknown")})
+	_, diags = r.readLabels(ctx, data)
+	assert.True(t, diags.HasError(), "the label set shall be defined by the provider")
+}
+
 func TestAccNodeResource(t *testing.T) {
 	t.Setenv("DB_URI", testDbURI)
 	t.Setenv("DB_USER", testDBUser)
@@ -89,25 +340,33 @@ func TestAccNodeResource(t *testing.T) {
 								regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[0-9a-f]{4}-[0-9a-f]{12}$`),
 							),
 						),
+						statecheck.ExpectKnownValue(resourceAddress, tfjsonpath.New("statements"),
+							knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact(nodeCreateQuery)})),
 						configInit,
 					},
 				},
 				// ImportState testing
 				{
-					ResourceName:      configInit.resourceAddress(),
-					ImportState:       true,
-					ImportStateVerify: true,
+					ResourceName:            configInit.resourceAddress(),
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"statements"},
 				},
 				// Update and Read testing
 				{
-					Config:            configNoLabels.generateConfig(),
-					ConfigStateChecks: []statecheck.StateCheck{configNoLabels},
+					Config: configNoLabels.generateConfig(),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(resourceAddress, tfjsonpath.New("statements"),
+							knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact(nodeUpdateQuery)})),
+						configNoLabels,
+					},
 				},
 				// ImportState testing
 				{
-					ResourceName:      configNoLabels.resourceAddress(),
-					ImportState:       true,
-					ImportStateVerify: true,
+					ResourceName:            configNoLabels.resourceAddress(),
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"statements"},
 				},
 				// Update and Read testing
 				{
@@ -116,9 +375,10 @@ func TestAccNodeResource(t *testing.T) {
 				},
 				// ImportState testing
 				{
-					ResourceName:      configPlain.resourceAddress(),
-					ImportState:       true,
-					ImportStateVerify: true,
+					ResourceName:            configPlain.resourceAddress(),
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"statements"},
 				},
 				// Update and Read testing
 				{
@@ -127,9 +387,10 @@ func TestAccNodeResource(t *testing.T) {
 				},
 				// ImportState testing
 				{
-					ResourceName:      configNoProperties.resourceAddress(),
-					ImportState:       true,
-					ImportStateVerify: true,
+					ResourceName:            configNoProperties.resourceAddress(),
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"statements"},
 				},
 				// Update and Read testing
 				{
@@ -167,9 +428,10 @@ func TestAccNodeResource(t *testing.T) {
 					ConfigStateChecks: []statecheck.StateCheck{cfgNull},
 				},
 				{
-					ResourceName:      cfgNull.resourceAddress(),
-					ImportState:       true,
-					ImportStateVerify: true,
+					ResourceName:            cfgNull.resourceAddress(),
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"statements"},
 				},
 				{
 					Config:            cfg.generateConfig(),
@@ -179,6 +441,404 @@ func TestAccNodeResource(t *testing.T) {
 		})
 	})
 
+	t.Run("adopt existing node", func(t *testing.T) {
+		if _, err := c.Run(ctx, `CREATE (:Adoptable{name:"adopt-me", legacy:true}), (:Adoptable{name:"twin"}),
+(:Adoptable{name:"twin"})`, nil); err != nil {
+			t.Fatalf("could not seed the graph: %v", err)
+		}
+		t.Cleanup(func() { _, _ = c.Run(ctx, `MATCH (n:Adoptable) DETACH DELETE n`, nil) })
+
+		const resourceAddress = resourceNodeName + ".adopted"
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: `resource "neo4j_node" "adopted" {
+labels          = ["Adoptable"]
+properties      = { name = "twin" }
+adopt_if_exists = true
+adopt_selector  = { name = "twin" }
+}`,
+					ExpectError: regexp.MustCompile(`ambiguous selector`),
+				},
+				{
+					// the uuid is removed from the node when the write fails, hence it's adopted by the next step
+					Config: `resource "neo4j_node" "adopted" {
+labels          = ["Adoptable"]
+properties      = { name = "adopt-me", owner = "terraform" }
+adopt_if_exists = true
+adopt_selector  = { name = "adopt-me" }
+postconditions  = [{ query = "RETURN false", message = "rejected" }]
+}`,
+					ExpectError: regexp.MustCompile(`postconditions failed: rejected`),
+				},
+				{
+					Config: `resource "neo4j_node" "adopted" {
+labels          = ["Adoptable"]
+properties      = { name = "adopt-me", owner = "terraform" }
+adopt_if_exists = true
+adopt_selector  = { name = "adopt-me" }
+}`,
+					Check: func(s *terraform.State) error {
+						id := s.RootModule().Resources[resourceAddress].Primary.ID
+						dbResp, err := c.Run(ctx, `MATCH (n:Adoptable{name:"adopt-me"})
+WITH collect(n) AS nodes
+CALL apoc.util.validate(size(nodes) <> 1, "the node shall not be duplicated", [])
+CALL apoc.util.validate(nodes[0].uuid <> $uuid, "the node shall be adopted", [])
+CALL apoc.util.validate(nodes[0].owner <> "terraform", "the node shall be updated", [])
+RETURN true`, map[string]any{"uuid": id})
+						if err != nil {
+							return err
+						}
+						_, err = dbResp.Single(ctx)
+						return err
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("adopt node outside ownership boundary", func(t *testing.T) {
+		if _, err := c.Run(ctx, `CREATE (:Foreign{name:"app-data"})`, nil); err != nil {
+			t.Fatalf("could not seed the graph: %v", err)
+		}
+		t.Cleanup(func() { _, _ = c.Run(ctx, `MATCH (n:Foreign) DETACH DELETE n`, nil) })
+
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: `provider "neo4j" {
+  ownership_selector {
+    properties = { owner = "terraform" }
+  }
+}
+
+resource "neo4j_node" "foreign" {
+labels          = ["Foreign"]
+properties      = { name = "app-data", owner = "terraform" }
+adopt_if_exists = true
+adopt_selector  = { name = "app-data" }
+}`,
+					ExpectError: regexp.MustCompile(`the node is not managed by the provider`),
+				},
+			},
+		})
+
+		// the node is neither adopted, nor updated
+		dbResp, err := c.Run(ctx, `MATCH (n:Foreign{name:"app-data"}) RETURN n.uuid IS NULL AND n.owner IS NULL`, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		rec, err := dbResp.Single(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assert.Equal(t, true, rec.Values[0])
+	})
+
+	t.Run("natural key duplicate", func(t *testing.T) {
+		if _, err := c.Run(ctx, `CREATE (:Country{code:"DE", uuid:"country-de"})`, nil); err != nil {
+			t.Fatalf("could not seed the graph: %v", err)
+		}
+		t.Cleanup(func() { _, _ = c.Run(ctx, `MATCH (n:Country) DETACH DELETE n`, nil) })
+
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: `resource "neo4j_node" "_" {
+labels      = ["Country"]
+properties  = { code = "DE", name = "Germany" }
+natural_key = ["code"]
+}`,
+					ExpectError: regexp.MustCompile(`(?s)duplicated node.*country-de`),
+				},
+				{
+					Config: `resource "neo4j_node" "_" {
+labels      = ["Country"]
+properties  = { code = "FR", name = "France" }
+natural_key = ["code"]
+}`,
+				},
+			},
+		})
+	})
+
+	t.Run("property types", func(t *testing.T) {
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: `resource "neo4j_node" "typed" {
+labels         = ["Address"]
+properties     = { zip = "01234", since = "2024-01-02T03:04:05Z", location = "13.4,52.5" }
+property_types = { zip = "string", since = "datetime", location = "point" }
+# the typed properties shall be read back as written
+verify_after_write = true
+}`,
+					Check: func(s *terraform.State) error {
+						id := s.RootModule().Resources[resourceNodeName+".typed"].Primary.ID
+						dbResp, err := c.Run(ctx, `MATCH (n{uuid:$uuid})
+CALL apoc.util.validate(n.zip <> "01234", "zip shall be stored as string", [])
+CALL apoc.util.validate(NOT n.since IS :: ZONED DATETIME, "since shall be stored as datetime", [])
+CALL apoc.util.validate(NOT n.location IS :: POINT, "location shall be stored as point", [])
+RETURN true`, map[string]any{"uuid": id})
+						if err != nil {
+							return err
+						}
+						_, err = dbResp.Single(ctx)
+						return err
+					},
+				},
+				{
+					Config: `resource "neo4j_node" "typed" {
+labels         = ["Address"]
+properties     = { zip = "01234", house = "7" }
+coerce_types   = false
+}`,
+					Check: func(s *terraform.State) error {
+						id := s.RootModule().Resources[resourceNodeName+".typed"].Primary.ID
+						dbResp, err := c.Run(ctx, `MATCH (n{uuid:$uuid})
+CALL apoc.util.validate(n.house <> "7", "house shall be stored as string", [])
+RETURN true`, map[string]any{"uuid": id})
+						if err != nil {
+							return err
+						}
+						_, err = dbResp.Single(ctx)
+						return err
+					},
+				},
+				{
+					ResourceName:            resourceNodeName + ".typed",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"statements", "coerce_types"},
+				},
+			},
+		})
+	})
+
+	t.Run("externally supplied id", func(t *testing.T) {
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: `resource "neo4j_node" "external" {
+id     = "crm:customer-42"
+labels = ["Customer"]
+}`,
+					Check: resource.TestCheckResourceAttr(resourceNodeName+".external", "id", "crm:customer-42"),
+				},
+				{
+					Config: `resource "neo4j_node" "external" {
+id     = "crm:customer-42"
+labels = ["Customer"]
+}
+
+resource "neo4j_node" "duplicate" {
+id     = "crm:customer-42"
+labels = ["Customer"]
+}`,
+					ExpectError: regexp.MustCompile(`duplicated node identifier`),
+				},
+				{
+					Config: `resource "neo4j_node" "invalid" {
+id     = "crm customer"
+labels = ["Customer"]
+}`,
+					ExpectError: regexp.MustCompile(`letters, digits`),
+				},
+			},
+		})
+	})
+
+	t.Run("preconditions", func(t *testing.T) {
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: `resource "neo4j_node" "order" {
+labels     = ["TestPreconditionOrder"]
+properties = { amount = "10" }
+preconditions = [
+  {
+    query   = "RETURN toInteger($properties.amount) > 0"
+    message = "The order amount shall be positive."
+  },
+]
+}`,
+					Check: resource.TestCheckResourceAttrSet(resourceNodeName+".order", "id"),
+				},
+				{
+					Config: `resource "neo4j_node" "order" {
+labels     = ["TestPreconditionOrder"]
+properties = { amount = "-1" }
+preconditions = [
+  {
+    query   = "RETURN toInteger($properties.amount) > 0"
+    message = "The order amount shall be positive."
+  },
+]
+}`,
+					ExpectError: regexp.MustCompile(`The order amount shall be positive`),
+				},
+				{
+					Config: `resource "neo4j_node" "order" {
+labels     = ["TestPreconditionOrder"]
+properties = { amount = "10" }
+preconditions = [
+  {
+    query   = "CREATE (n) RETURN true"
+    message = "The writes are not allowed."
+  },
+]
+}`,
+					ExpectError: regexp.MustCompile(`the write clauses are not allowed`),
+				},
+			},
+		})
+	})
+
+	t.Run("postconditions", func(t *testing.T) {
+		t.Cleanup(func() {
+			_, _ = c.Run(ctx, `MATCH (n:TestPostconditionSku) DELETE n`, nil)
+		})
+		_, err := c.Run(ctx, `CREATE (n:TestPostconditionSku{code: "A1"})`, nil)
+		if err != nil {
+			t.Fatalf("failed to create the node: %v", err)
+		}
+
+		const postconditions = `postconditions = [
+  {
+    query   = "MATCH (n:TestPostconditionSku{code: $properties.code}) RETURN count(n) = 1"
+    message = "The SKU code shall be unique."
+  },
+]`
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: `resource "neo4j_node" "sku" {
+labels     = ["TestPostconditionSku"]
+properties = { code = "B1" }
+` + postconditions + `
+}`,
+					Check: resource.TestCheckResourceAttrSet(resourceNodeName+".sku", "id"),
+				},
+				{
+					Config: `resource "neo4j_node" "sku" {
+labels     = ["TestPostconditionSku"]
+properties = { code = "A1" }
+` + postconditions + `
+}`,
+					ExpectError: regexp.MustCompile(`The SKU code shall be unique`),
+				},
+				{
+					// the failed update is rolled back
+					Config: `resource "neo4j_node" "sku" {
+labels     = ["TestPostconditionSku"]
+properties = { code = "B1" }
+` + postconditions + `
+}`,
+					PlanOnly: true,
+				},
+			},
+		})
+	})
+
+	t.Run("merge update mode", func(t *testing.T) {
+		t.Cleanup(func() {
+			_, _ = c.Run(ctx, `MATCH (n:TestMergeUpdate) DELETE n`, nil)
+		})
+		const address = resourceNodeName + ".merged"
+
+		// checkProperties checks the node's properties in the database.
+		checkProperties := func(want map[string]any) resource.TestCheckFunc {
+			return func(_ *terraform.State) error {
+				dbResp, err := c.Run(ctx, `MATCH (n:TestMergeUpdate) RETURN properties(n)`, nil)
+				if err != nil {
+					return err
+				}
+				rec, err := dbResp.Single(ctx)
+				if err != nil {
+					return err
+				}
+				got, _ := rec.Values[0].(map[string]any)
+				delete(got, "uuid")
+				if !reflect.DeepEqual(want, got) {
+					return fmt.Errorf("expected properties %v, got %v", want, got)
+				}
+				return nil
+			}
+		}
+
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: `resource "neo4j_node" "merged" {
+labels      = ["TestMergeUpdate"]
+properties  = { name = "a", legacy = "x" }
+update_mode = "merge"
+}`,
+				},
+				// the property set by the application is kept, and the property set to null is removed
+				{
+					PreConfig: func() {
+						if _, err := c.Run(ctx, `MATCH (n:TestMergeUpdate) SET n.visits = 1`, nil); err != nil {
+							t.Fatalf("failed to update the node: %v", err)
+						}
+					},
+					Config: `resource "neo4j_node" "merged" {
+labels             = ["TestMergeUpdate"]
+properties         = { name = "b", legacy = null }
+update_mode        = "merge"
+verify_after_write = true
+}`,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(address, "properties.name", "b"),
+						resource.TestCheckNoResourceAttr(address, "properties.visits"),
+						checkProperties(map[string]any{"name": "b", "visits": int64(1)}),
+					),
+				},
+				{
+					Config: `resource "neo4j_node" "merged" {
+labels             = ["TestMergeUpdate"]
+properties         = { name = "b", legacy = null }
+update_mode        = "merge"
+verify_after_write = true
+}`,
+					PlanOnly: true,
+				},
+			},
+		})
+	})
+
+	t.Run("unicode and special characters labels", func(t *testing.T) {
+		cfg := configNode{
+			client:            c,
+			resourceTfVarName: "_",
+			WantLabels:        []string{"Point of Interest", "café-bar", "Städte", "label`with`backticks"},
+			WantProperties:    map[string]any{"name": "Zürich Straße"},
+		}
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:            cfg.generateConfig(),
+					ConfigStateChecks: []statecheck.StateCheck{cfg},
+				},
+				{
+					ResourceName:            cfg.resourceAddress(),
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"statements", "labels"},
+				},
+			},
+		})
+	})
+
 	t.Run("properties = {} vs properties = null", func(t *testing.T) {
 		cfg := configNode{
 			client:            c,
@@ -200,9 +860,10 @@ func TestAccNodeResource(t *testing.T) {
 					ConfigStateChecks: []statecheck.StateCheck{cfgNull},
 				},
 				{
-					ResourceName:      cfgNull.resourceAddress(),
-					ImportState:       true,
-					ImportStateVerify: true,
+					ResourceName:            cfgNull.resourceAddress(),
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"statements"},
 				},
 				{
 					Config:            cfg.generateConfig(),
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NodesByIDsDataSource{}
+
+func NewNodesByIDsDataSource() datasource.DataSource {
+	return &NodesByIDsDataSource{}
+}
+
+// NodesByIDsDataSource defines the data source to read the nodes by their identifiers in a single query.
+type NodesByIDsDataSource struct {
+	client *Client
+}
+
+// NodesByIDsDataSourceModel describes the data source data model.
+type NodesByIDsDataSourceModel struct {
+	IDs        types.List `tfsdk:"ids"`
+	Nodes      types.Map  `tfsdk:"nodes"`
+	MissingIDs types.List `tfsdk:"missing_ids"`
+}
+
+// NodeModel describes the node read by the data source.
+type NodeModel struct {
+	Labels     types.List `tfsdk:"labels"`
+	Properties types.Map  `tfsdk:"properties"`
+}
+
+var nodeAttrTypes = map[string]attr.Type{
+	"labels":     types.ListType{ElemType: types.StringType},
+	"properties": types.MapType{ElemType: types.StringType},
+}
+
+const nodesByIDsSuffix = "_nodes_by_ids"
+
+const nodesByIDsQuery = `UNWIND $ids AS id
+MATCH (n{uuid:id})
+RETURN id, labels(n), properties(n)`
+
+func (d *NodesByIDsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + nodesByIDsSuffix
+}
+
+func (d *NodesByIDsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the nodes by their IDs in a single query, " +
+			"e.g. to iterate over the nodes with `for_each` instead of defining a data source per node.",
+		Attributes: map[string]schema.Attribute{
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the nodes to read.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+			},
+			"nodes": schema.MapNestedAttribute{
+				MarkdownDescription: "The found nodes by their IDs.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"labels": schema.ListAttribute{
+							MarkdownDescription: "Node labels.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"properties": schema.MapAttribute{
+							MarkdownDescription: "Node properties.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+			"missing_ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the nodes which were not found.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *NodesByIDsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NodesByIDsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+	var data NodesByIDsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ids []string
+	resp.Diagnostics.Append(data.IDs.ElementsAs(ctx, &ids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "reading the nodes", map[string]interface{}{"count": len(ids)})
+
+	dbResp, err := d.client.RunRead(ctx, nodesByIDsQuery, map[string]any{"ids": ids})
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the nodes")
+		resp.Diagnostics.AddError("failed to read the nodes", err.Error())
+		return
+	}
+
+	var nodes = make(map[string]NodeModel, len(dbResp.Records))
+	for _, rec := range dbResp.Records {
+		id, _ := rec.Values[0].(string)
+		var node NodeModel
+		var diags diag.Diagnostics
+		node.Labels, diags = toLabels(ctx, rec.Values[1])
+		resp.Diagnostics.Append(diags...)
+
+		var properties = map[string]string{}
+		if props, ok := rec.Values[2].(map[string]any); ok {
+			for k, v := range props {
+				// Exclude the system property used to store the resource id.
+				if k != "uuid" {
+					properties[k] = formatProperty(v)
+				}
+			}
+		}
+		node.Properties, diags = types.MapValueFrom(ctx, types.StringType, properties)
+		resp.Diagnostics.Append(diags...)
+		nodes[id] = node
+	}
+
+	var missing = make([]string, 0)
+	for _, id := range ids {
+		if _, ok := nodes[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+
+	var diags diag.Diagnostics
+	data.Nodes, diags = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: nodeAttrTypes}, nodes)
+	resp.Diagnostics.Append(diags...)
+	data.MissingIDs, diags = types.ListValueFrom(ctx, types.StringType, missing)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the nodes", map[string]interface{}{"count": len(nodes)})
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccNodesByIDsDataSource(t *testing.T) {
+	t.Setenv("DB_URI", testDbURI)
+	t.Setenv("DB_USER", testDBUser)
+	t.Cleanup(func() {
+		t.Setenv("DB_URI", "")
+		t.Setenv("DB_USER", "")
+	})
+
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err != nil {
+		t.Errorf("could not conenct to database: %v\n", err)
+		return
+	}
+	t.Cleanup(func() { _ = c.Close(ctx) })
+
+	if _, err = c.Run(ctx, `CREATE (:NodesByIDs:Person{uuid:"by-id-0", name:"Alice", age:42}),
+(:NodesByIDs{uuid:"by-id-1"})`, nil); err != nil {
+		t.Errorf("could not seed the graph: %v\n", err)
+		return
+	}
+	t.Cleanup(func() {
+		_, _ = c.Run(ctx, `MATCH (n:NodesByIDs) DETACH DELETE n`, nil)
+	})
+
+	const dataSourceAddress = "data." + Name + nodesByIDsSuffix + "._"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "neo4j_nodes_by_ids" "_" {
+ids = ["by-id-0", "by-id-1", "by-id-missing"]
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("nodes"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"by-id-0": knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"labels": knownvalue.SetExact([]knownvalue.Check{
+									knownvalue.StringExact("NodesByIDs"), knownvalue.StringExact("Person"),
+								}),
+								"properties": knownvalue.MapExact(map[string]knownvalue.Check{
+									"name": knownvalue.StringExact("Alice"),
+									"age":  knownvalue.StringExact("42"),
+								}),
+							}),
+							"by-id-1": knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"labels": knownvalue.ListExact([]knownvalue.Check{
+									knownvalue.StringExact("NodesByIDs"),
+								}),
+								"properties": knownvalue.MapExact(map[string]knownvalue.Check{}),
+							}),
+						})),
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("missing_ids"),
+						knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("by-id-missing")})),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ModelOwnershipSelector describes the boundary of the subgraph managed by the provider.
+type ModelOwnershipSelector struct {
+	Label      types.String `tfsdk:"label"`
+	Properties types.Map    `tfsdk:"properties"`
+}
+
+// ownershipSelectorBlock defines the schema of the ownership boundary.
+func ownershipSelectorBlock() schema.Block {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "The boundary of the subgraph managed by the provider, " +
+			"e.g. to prevent the mistakes in the state, or the configuration from changing the application's data. " +
+			"The nodes and relationships outside the boundary are neither updated, nor deleted, " +
+			"and the new ones shall be created within it.",
+		Attributes: map[string]schema.Attribute{
+			"label": schema.StringAttribute{
+				MarkdownDescription: "The label of the managed nodes. " +
+					"The managed relationships connect the nodes with the label.",
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"properties": schema.MapAttribute{
+				MarkdownDescription: "The properties of the managed nodes and relationships, " +
+					"the values are compared as strings.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  []validator.Map{mapvalidator.SizeAtLeast(1)},
+			},
+		},
+	}
+}
+
+// ownershipSelector defines the boundary of the subgraph managed by the provider.
+type ownershipSelector struct {
+	label      string
+	properties map[string]string
+}
+
+// newOwnershipSelector defines the selector from the provider configuration, nil if not configured.
+func newOwnershipSelector(ctx context.Context, m *ModelOwnershipSelector) (*ownershipSelector, error) {
+	if m == nil {
+		return nil, nil
+	}
+	var s = &ownershipSelector{label: m.Label.ValueString()}
+	if !m.Properties.IsNull() {
+		if diags := m.Properties.ElementsAs(ctx, &s.properties, false); diags.HasError() {
+			return nil, fmt.Errorf("failed to read the ownership selector's properties: %v", diags)
+		}
+	}
+	if s.label == "" && len(s.properties) == 0 {
+		return nil, errors.New("the ownership selector shall define the label, or the properties")
+	}
+	return s, nil
+}
+
+// query defines the query to check if the entity is owned, it returns nothing if the entity doesn't exist.
+// The label and the property keys cannot be passed as parameters, hence they are escaped and embedded,
+// the properties' values are passed as the list parameter `values`.
+func (s *ownershipSelector) query(entity string) (query string, values []string) {
+	keys := make([]string, 0, len(s.properties))
+	for k := range s.properties {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var conditions []string
+	for i, k := range keys {
+		conditions = append(conditions, fmt.Sprintf("toString(e.%s) = $values[%d]", quoteName(k), i))
+		values = append(values, s.properties[k])
+	}
+
+	pattern := "(e{uuid:$uuid})"
+	if entity == entityTypeRelationship {
+		pattern = "(src)-[e{uuid:$uuid}]->(dst)"
+	}
+	if s.label != "" {
+		if entity == entityTypeRelationship {
+			conditions = append(conditions, "src:"+quoteName(s.label), "dst:"+quoteName(s.label))
+		} else {
+			conditions = append(conditions, "e:"+quoteName(s.label))
+		}
+	}
+	return "MATCH " + pattern + " RETURN " + strings.Join(conditions, " AND "), values
+}
+
+// hasProperties checks if the new entity's properties are within the boundary.
+func (s *ownershipSelector) hasProperties(properties map[string]any) bool {
+	for k, want := range s.properties {
+		v, ok := properties[k]
+		if !ok || formatProperty(v) != want {
+			return false
+		}
+	}
+	return true
+}
+
+// checkOwned verifies that the existing entity is within the ownership boundary,
+// it's no-op if the boundary is not configured, or the entity doesn't exist.
+func (c *Client) checkOwned(ctx context.Context, entity, id string) (diags diag.Diagnostics) {
+	if c == nil || c.ownership == nil {
+		return diags
+	}
+	var name = strings.ToLower(entity)
+	query, values := c.ownership.query(entity)
+	dbResp, err := c.Run(ctx, query, map[string]any{"uuid": id, "values": values})
+	if err != nil {
+		diags.AddError("failed to check the "+name+" ownership", err.Error())
+		return diags
+	}
+	records, err := dbResp.Collect(ctx)
+	if err != nil {
+		diags.AddError("failed to check the "+name+" ownership", err.Error())
+		return diags
+	}
+	for _, rec := range records {
+		if owned, _ := rec.Values[0].(bool); !owned {
+			diags.AddError("the "+name+" is not managed by the provider",
+				fmt.Sprintf("The %s %s is outside the provider's ownership_selector, hence it's not changed.",
+					name, id))
+			return diags
+		}
+	}
+	return diags
+}
+
+// ownershipError reports the entity outside the ownership boundary.
+func (c *Client) ownershipError(entity string) (diags diag.Diagnostics) {
+	name := strings.ToLower(entity)
+	diags.AddError("the "+name+" is not managed by the provider",
+		fmt.Sprintf("The new %s shall match the provider's ownership_selector: the label %q, the properties %v.",
+			name, c.ownership.label, c.ownership.properties))
+	return diags
+}
+
+// checkOwnsNewNode verifies that the new node is within the ownership boundary.
+func (c *Client) checkOwnsNewNode(labels []string, properties map[string]any) (diags diag.Diagnostics) {
+	if c == nil || c.ownership == nil {
+		return diags
+	}
+	if (c.ownership.label != "" && !slices.Contains(labels, c.ownership.label)) ||
+		!c.ownership.hasProperties(properties) {
+		return c.ownershipError(entityTypeNode)
+	}
+	return diags
+}
+
+// checkOwnsNewRelationship verifies that the new relationship is within the ownership boundary,
+// i.e. it has the boundary's properties and connects the nodes with the boundary's label.
+func (c *Client) checkOwnsNewRelationship(ctx context.Context, startID, endID string,
+	properties map[string]any) (diags diag.Diagnostics) {
+	if c == nil || c.ownership == nil {
+		return diags
+	}
+	if !c.ownership.hasProperties(properties) {
+		return c.ownershipError(entityTypeRelationship)
+	}
+	if c.ownership.label == "" {
+		return diags
+	}
+
+	label := quoteName(c.ownership.label)
+	dbResp, err := c.Run(ctx, "MATCH (src{uuid:$src}), (dst{uuid:$dst}) RETURN src:"+label+" AND dst:"+label,
+		map[string]any{"src": startID, "dst": endID})
+	if err != nil {
+		diags.AddError("failed to check the relationship ownership", err.Error())
+		return diags
+	}
+	records, err := dbResp.Collect(ctx)
+	if err != nil {
+		diags.AddError("failed to check the relationship ownership", err.Error())
+		return diags
+	}
+	for _, rec := range records {
+		if owned, _ := rec.Values[0].(bool); !owned {
+			return c.ownershipError(entityTypeRelationship)
+		}
+	}
+	return diags
+}
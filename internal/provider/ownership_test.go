@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOwnershipSelectorQuery(t *testing.T) {
+	s := &ownershipSelector{label: "Managed", properties: map[string]string{"team": "data", "env": "prod"}}
+
+	query, values := s.query(entityTypeNode)
+	assert.Equal(t, "MATCH (e{uuid:$uuid}) RETURN toString(e.`env`) = $values[0] AND "+
+		"toString(e.`team`) = $values[1] AND e:`Managed`", query)
+	assert.Equal(t, []string{"prod", "data"}, values)
+
+	query, _ = s.query(entityTypeRelationship)
+	assert.Equal(t, "MATCH (src)-[e{uuid:$uuid}]->(dst) RETURN toString(e.`env`) = $values[0] AND "+
+		"toString(e.`team`) = $values[1] AND src:`Managed` AND dst:`Managed`", query)
+}
+
+func TestClientCheckOwnsNewNode(t *testing.T) {
+	c := &Client{ownership: &ownershipSelector{label: "Managed", properties: map[string]string{"count": "1"}}}
+	assert.False(t, c.checkOwnsNewNode([]string{"Managed", "User"}, map[string]any{"count": int64(1)}).HasError())
+	assert.True(t, c.checkOwnsNewNode([]string{"User"}, map[string]any{"count": int64(1)}).HasError())
+	assert.True(t, c.checkOwnsNewNode([]string{"Managed"}, map[string]any{"count": int64(2)}).HasError())
+	assert.False(t, (&Client{}).checkOwnsNewNode(nil, nil).HasError())
+}
+
+func TestClientCheckOwned(t *testing.T) {
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+		OwnershipSelector: &ModelOwnershipSelector{
+			Label:      types.StringValue("TestOwned"),
+			Properties: types.MapNull(types.StringType),
+		},
+	})
+	if err != nil {
+		t.Fatalf("could not conenct to database: %v\n", err)
+	}
+	t.Cleanup(func() { _ = c.Close(ctx) })
+
+	if _, err = c.Run(ctx, `CREATE (:TestOwned{uuid:"owned"}), (:TestNotOwned{uuid:"not-owned"})`, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assert.False(t, c.checkOwned(ctx, entityTypeNode, "owned").HasError())
+	assert.True(t, c.checkOwned(ctx, entityTypeNode, "not-owned").HasError())
+	assert.False(t, c.checkOwned(ctx, entityTypeNode, "missing").HasError(),
+		"the missing entity shall be handled by the resource")
+}
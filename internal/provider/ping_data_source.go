@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PingDataSource{}
+
+func NewPingDataSource() datasource.DataSource {
+	return &PingDataSource{}
+}
+
+// PingDataSource defines the data source to test the connection to the database.
+type PingDataSource struct {
+	client *Client
+}
+
+// PingDataSourceModel describes the data source data model.
+type PingDataSourceModel struct {
+	Database        types.String  `tfsdk:"database"`
+	LatencyMs       types.Float64 `tfsdk:"latency_ms"`
+	Address         types.String  `tfsdk:"address"`
+	Agent           types.String  `tfsdk:"agent"`
+	ProtocolVersion types.String  `tfsdk:"protocol_version"`
+	Routers         types.List    `tfsdk:"routers"`
+	Readers         types.List    `tfsdk:"readers"`
+	Writers         types.List    `tfsdk:"writers"`
+}
+
+const pingSuffix = "_ping"
+
+// routingTable groups the addresses of the routing table's servers by their role.
+func routingTable(servers []any) map[string][]string {
+	var table = map[string][]string{"ROUTE": {}, "READ": {}, "WRITE": {}}
+	for _, v := range servers {
+		server, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		role, _ := server["role"].(string)
+		addresses, _ := server["addresses"].([]any)
+		for _, address := range addresses {
+			table[role] = append(table[role], fmt.Sprintf("%v", address))
+		}
+	}
+	for _, addresses := range table {
+		slices.Sort(addresses)
+	}
+	return table
+}
+
+func (d *PingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + pingSuffix
+}
+
+func (d *PingDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	listAttribute := func(description string) schema.Attribute {
+		return schema.ListAttribute{
+			MarkdownDescription: description,
+			Computed:            true,
+			ElementType:         types.StringType,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Tests the connection to the database, and reads the round-trip latency and " +
+			"the routing table, e.g. to assert that the provider is connected to the intended cluster " +
+			"in the `check` blocks, details: " +
+			"https://neo4j.com/docs/operations-manual/current/procedures/#procedure_dbms_routing_getroutingtable",
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				MarkdownDescription: "The name, or the alias of the database to read the routing table of. " +
+					"Defaults to the database managed by the provider.",
+				Optional: true,
+			},
+			"latency_ms": schema.Float64Attribute{
+				MarkdownDescription: "The round-trip latency of the trivial query in milliseconds.",
+				Computed:            true,
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "The address of the server the driver is connected to.",
+				Computed:            true,
+			},
+			"agent": schema.StringAttribute{
+				MarkdownDescription: "The server agent, e.g. `Neo4j/5.26.0`.",
+				Computed:            true,
+			},
+			"protocol_version": schema.StringAttribute{
+				MarkdownDescription: "The version of the Bolt protocol, e.g. `5.4`.",
+				Computed:            true,
+			},
+			"routers": listAttribute("The addresses of the servers which route the queries."),
+			"readers": listAttribute("The addresses of the servers which serve the reads."),
+			"writers": listAttribute("The addresses of the servers which serve the writes."),
+		},
+	}
+}
+
+func (d *PingDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PingDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "ping the database")
+
+	start := time.Now()
+	dbResp, err := d.client.Run(ctx, `RETURN 1`, nil)
+	if err == nil {
+		_, err = dbResp.Consume(ctx)
+	}
+	if err != nil {
+		tflog.Debug(ctx, "failed to ping the database")
+		resp.Diagnostics.AddError("failed to ping the database", err.Error())
+		return
+	}
+	data.LatencyMs = types.Float64Value(float64(time.Since(start).Microseconds()) / 1000)
+
+	info, err := d.client.driver.GetServerInfo(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the server info")
+		resp.Diagnostics.AddError("failed to read the server info", err.Error())
+		return
+	}
+	data.Address = types.StringValue(info.Address())
+	data.Agent = types.StringValue(info.Agent())
+	data.ProtocolVersion = types.StringValue(fmt.Sprintf("%d.%d",
+		info.ProtocolVersion().Major, info.ProtocolVersion().Minor))
+
+	var params = map[string]any{"database": nil}
+	if !data.Database.IsNull() {
+		params["database"] = data.Database.ValueString()
+	}
+	dbResp, err = d.client.Run(ctx, `CALL dbms.routing.getRoutingTable({}, $database) YIELD servers
+RETURN servers`, params)
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the routing table")
+		resp.Diagnostics.AddError("failed to read the routing table", err.Error())
+		return
+	}
+	rec, err := dbResp.Single(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the routing table")
+		resp.Diagnostics.AddError("failed to read the routing table", err.Error())
+		return
+	}
+	servers, _ := rec.Values[0].([]any)
+	table := routingTable(servers)
+	for _, v := range []struct {
+		role   string
+		target *types.List
+	}{
+		{"ROUTE", &data.Routers},
+		{"READ", &data.Readers},
+		{"WRITE", &data.Writers},
+	} {
+		var diags diag.Diagnostics
+		*v.target, diags = types.ListValueFrom(ctx, types.StringType, table[v.role])
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "pinged the database", map[string]interface{}{"latency_ms": data.LatencyMs.ValueFloat64()})
+}
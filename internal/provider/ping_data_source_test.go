@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestRoutingTable(t *testing.T) {
+	got := routingTable([]any{
+		map[string]any{"role": "WRITE", "addresses": []any{"core-0:7687"}},
+		map[string]any{"role": "READ", "addresses": []any{"core-2:7687", "core-1:7687"}},
+		map[string]any{"role": "ROUTE", "addresses": []any{"core-0:7687"}},
+		"unexpected",
+	})
+	want := map[string][]string{
+		"ROUTE": {"core-0:7687"},
+		"READ":  {"core-1:7687", "core-2:7687"},
+		"WRITE": {"core-0:7687"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected routing table, want: %v, got: %v", want, got)
+	}
+}
+
+func TestAccPingDataSource(t *testing.T) {
+	t.Setenv("DB_URI", testDbURI)
+	t.Setenv("DB_USER", testDBUser)
+	t.Cleanup(func() {
+		t.Setenv("DB_URI", "")
+		t.Setenv("DB_USER", "")
+	})
+
+	const dataSourceAddress = "data." + Name + pingSuffix + "._"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "neo4j_ping" "_" {}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("writers"),
+						knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("agent"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^Neo4j/5\.`))),
+				},
+			},
+			{
+				Config: `data "neo4j_ping" "_" {
+database = "system"
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("routers"),
+						knownvalue.ListSizeExact(1)),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PropertyRenameResource{}
+
+func NewPropertyRenameResource() resource.Resource {
+	return &PropertyRenameResource{}
+}
+
+// PropertyRenameResource defines the resource to rename the property key of the nodes, or the relationships.
+type PropertyRenameResource struct {
+	client *Client
+}
+
+// PropertyRenameResourceModel describes the resource data model.
+type PropertyRenameResourceModel struct {
+	EntityType  types.String `tfsdk:"entity_type"`
+	LabelOrType types.String `tfsdk:"label_or_type"`
+	From        types.String `tfsdk:"from"`
+	To          types.String `tfsdk:"to"`
+	BatchSize   types.Int64  `tfsdk:"batch_size"`
+	Renamed     types.Int64  `tfsdk:"renamed"`
+}
+
+const (
+	propertyRenameSuffix = "_property_rename"
+
+	propertyRenameDefaultBatchSize = 10000
+)
+
+// propertyRenameStatements defines the statements to iterate over the entities which have the property,
+// and to rename it. The label, type and property keys cannot be passed as parameters,
+// hence they are escaped and embedded into the statements.
+func propertyRenameStatements(entityType, labelOrType, from, to string) (iterate, action string) {
+	var pattern = "(e:" + quoteName(labelOrType) + ")"
+	if entityType == entityTypeRelationship {
+		pattern = "()-[e:" + quoteName(labelOrType) + "]->()"
+	}
+	iterate = "MATCH " + pattern + " WHERE e." + quoteName(from) + " IS NOT NULL RETURN e"
+	action = "SET e." + quoteName(to) + " = e." + quoteName(from) + " REMOVE e." + quoteName(from)
+	return iterate, action
+}
+
+func (r *PropertyRenameResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + propertyRenameSuffix
+}
+
+func (r *PropertyRenameResource) Schema(_ context.Context, _ resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Renames the property key of all nodes with the label, or of all relationships " +
+			"of the type in batches using APOC, details: " +
+			"https://neo4j.com/docs/apoc/current/overview/apoc.periodic/apoc.periodic.iterate/" +
+			"\n\n-> **Note** The property is renamed once when the resource is created, " +
+			"and again only when the resource is replaced. The renamed property is kept when the resource is destroyed." +
+			"\n\n!>**Warning** The resource requires the APOC plugin. " +
+			"The value of the property with the new key is overwritten on the entities which have both keys.",
+		Attributes: map[string]schema.Attribute{
+			"entity_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the entities: `NODE` or `RELATIONSHIP`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(entityTypeNode, entityTypeRelationship),
+				},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"label_or_type": schema.StringAttribute{
+				MarkdownDescription: "The label of the nodes, or the type of the relationships.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"from": schema.StringAttribute{
+				MarkdownDescription: "The property key to rename.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "The new property key.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.NoneOfCaseInsensitive("uuid"),
+				},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"batch_size": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The number of entities renamed in a single transaction. "+
+					"Defaults to %d.", propertyRenameDefaultBatchSize),
+				Optional:   true,
+				Computed:   true,
+				Default:    int64default.StaticInt64(propertyRenameDefaultBatchSize),
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"renamed": schema.Int64Attribute{
+				MarkdownDescription: "The number of entities the property was renamed on.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *PropertyRenameResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// rename renames the property in batches, and returns the number of the entities it was renamed on.
+func (r *PropertyRenameResource) rename(ctx context.Context, data PropertyRenameResourceModel) (int64, error) {
+	iterate, action := propertyRenameStatements(data.EntityType.ValueString(), data.LabelOrType.ValueString(),
+		data.From.ValueString(), data.To.ValueString())
+	dbResp, err := r.client.Run(ctx, `CALL apoc.periodic.iterate($iterate, $action, {batchSize: $batchSize})
+YIELD total, failedBatches, errorMessages
+RETURN total, failedBatches, errorMessages`, map[string]any{
+		"iterate":   iterate,
+		"action":    action,
+		"batchSize": data.BatchSize.ValueInt64(),
+	})
+	if err != nil {
+		return 0, err
+	}
+	rec, err := dbResp.Single(ctx)
+	if err != nil {
+		return 0, err
+	}
+	// The failed batches are rolled back by APOC, but the procedure itself succeeds.
+	m := rec.AsMap()
+	if v, ok := m["failedBatches"].(int64); ok && v > 0 {
+		return 0, fmt.Errorf("%d batches failed: %v", v, m["errorMessages"])
+	}
+	total, _ := m["total"].(int64)
+	return total, nil
+}
+
+func (r *PropertyRenameResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+	var data PropertyRenameResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+propertyRenameSuffix, auditOperationCreate, &data.From)
+
+	if data.From.Equal(data.To) {
+		resp.Diagnostics.AddAttributeError(path.Root("to"), "invalid property key",
+			"the new property key shall differ from the renamed one")
+		return
+	}
+
+	props := map[string]interface{}{
+		"label_or_type": data.LabelOrType.ValueString(),
+		"from":          data.From.ValueString(),
+		"to":            data.To.ValueString(),
+	}
+	tflog.Trace(ctx, "rename the property", props)
+
+	renamed, err := r.rename(ctx, data)
+	if err != nil {
+		tflog.Debug(ctx, "failed to rename the property", props)
+		resp.Diagnostics.AddError("failed to rename the property", err.Error())
+		return
+	}
+
+	data.Renamed = types.Int64Value(renamed)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "renamed the property", props)
+}
+
+func (r *PropertyRenameResource) Read(ctx context.Context, req resource.ReadRequest,
+	resp *resource.ReadResponse) {
+	// The rename is a one-off action, hence the state is kept as is.
+	var data PropertyRenameResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PropertyRenameResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+	// The batch size only affects the next rename, hence the plan is only copied to the state.
+	var data PropertyRenameResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PropertyRenameResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// The renamed property is kept.
+}
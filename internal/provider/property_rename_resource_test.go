@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPropertyRenameStatements(t *testing.T) {
+	iterate, action := propertyRenameStatements(entityTypeNode, "Person", "name", "full name")
+	assert.Equal(t, "MATCH (e:`Person`) WHERE e.`name` IS NOT NULL RETURN e", iterate)
+	assert.Equal(t, "SET e.`full name` = e.`name` REMOVE e.`name`", action)
+
+	iterate, action = propertyRenameStatements(entityTypeRelationship, "KNOWS`]->() DETACH DELETE e //", "since", "from")
+	assert.Equal(t, "MATCH ()-[e:`KNOWS``]->() DETACH DELETE e //`]->() WHERE e.`since` IS NOT NULL RETURN e", iterate)
+	assert.Equal(t, "SET e.`from` = e.`since` REMOVE e.`since`", action)
+}
+
+func TestAccPropertyRenameResource(t *testing.T) {
+	t.Setenv("DB_URI", testDbURI)
+	t.Setenv("DB_USER", testDBUser)
+	t.Cleanup(func() {
+		t.Setenv("DB_URI", "")
+		t.Setenv("DB_USER", "")
+	})
+
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err != nil {
+		t.Errorf("could not conenct to database: %v\n", err)
+		return
+	}
+	t.Cleanup(func() { _ = c.Close(ctx) })
+	t.Cleanup(func() {
+		_, _ = c.Run(ctx, `MATCH (n:PropertyRename) DETACH DELETE n`, nil)
+	})
+
+	if _, err := c.Run(ctx, `UNWIND range(1, 5) AS id CREATE (:PropertyRename{id: id, old: "v" + id})`,
+		nil); err != nil {
+		t.Errorf("could not create the nodes: %v\n", err)
+		return
+	}
+
+	// checkRenamed checks the number of the nodes with the old and the new property keys.
+	checkRenamed := func(wantOld, wantNew int64) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			r, err := c.Run(ctx, `MATCH (n:PropertyRename)
+RETURN count(n.old) AS old, count(n.new) AS new`, nil)
+			if err != nil {
+				return err
+			}
+			rec, err := r.Single(ctx)
+			if err != nil {
+				return err
+			}
+			if gotOld, gotNew := rec.Values[0].(int64), rec.Values[1].(int64); gotOld != wantOld || gotNew != wantNew {
+				return fmt.Errorf("expected %d old and %d new properties, got %d and %d",
+					wantOld, wantNew, gotOld, gotNew)
+			}
+			return nil
+		}
+	}
+
+	const resourceAddress = Name + propertyRenameSuffix + "._"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "neo4j_property_rename" "_" {
+entity_type   = "NODE"
+label_or_type = "PropertyRename"
+from          = "old"
+to            = "new"
+batch_size    = 2
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceAddress, "renamed", "5"),
+					checkRenamed(0, 5),
+				),
+			},
+			// the changed batch size does not trigger the rename
+			{
+				Config: `resource "neo4j_property_rename" "_" {
+entity_type   = "NODE"
+label_or_type = "PropertyRename"
+from          = "old"
+to            = "new"
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceAddress, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(resourceAddress, "renamed", "5"),
+			},
+		},
+	})
+}
@@ -7,16 +7,28 @@ package provider
 import (
 	"cmp"
 	"context"
+	"errors"
+	"fmt"
 	"os"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 )
 
@@ -44,8 +56,43 @@ type ModelProvider struct {
 	DatabaseName     types.String `tfsdk:"db_name"`
 	DatabaseUser     types.String `tfsdk:"db_user"`
 	DatabasePassword types.String `tfsdk:"db_password"`
+	FallbackURIs     types.List   `tfsdk:"fallback_uris"`
+	AuthDisabled     types.Bool   `tfsdk:"auth_disabled"`
+
+	MaxConcurrentOperations types.Int64 `tfsdk:"max_concurrent_operations"`
+
+	QueryLogPath   types.String `tfsdk:"query_log_path"`
+	QueryLogParams types.List   `tfsdk:"query_log_params"`
+
+	IdentityStrategy types.String `tfsdk:"identity_strategy"`
+
+	RoleUsers types.Map `tfsdk:"role_users"`
+
+	LabelSets types.Map `tfsdk:"label_sets"`
+
+	ConsistentRefresh types.Bool `tfsdk:"consistent_refresh"`
+
+	TransactionGuardLimit     types.Int64  `tfsdk:"transaction_guard_limit"`
+	TransactionGuardThreshold types.String `tfsdk:"transaction_guard_threshold"`
+	TransactionGuardWait      types.String `tfsdk:"transaction_guard_wait"`
+
+	WriteRetryMaxTime   types.String  `tfsdk:"write_retry_max_time"`
+	WriteRetryBaseDelay types.String  `tfsdk:"write_retry_base_delay"`
+	WriteRetryJitter    types.Float64 `tfsdk:"write_retry_jitter"`
+
+	Driver            *ModelDriver            `tfsdk:"driver"`
+	OwnershipSelector *ModelOwnershipSelector `tfsdk:"ownership_selector"`
+	AuditLog          *ModelAuditLog          `tfsdk:"audit_log"`
+	Webhooks          []ModelWebhook          `tfsdk:"webhook"`
 }
 
+const (
+	// identityStrategyUUIDv4 generates the random identifiers.
+	identityStrategyUUIDv4 = "uuid_v4"
+	// identityStrategyUUIDv7 generates the time-ordered identifiers.
+	identityStrategyUUIDv7 = "uuid_v7"
+)
+
 func (p *Provider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = Name
 	resp.Version = p.version
@@ -55,13 +102,26 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `Terraform provider to manage Neo4j resources.
 
-!>**Warning** The minimal supported version of Neo4j is 5.24.`,
+!>**Warning** The minimal supported version of Neo4j is 5.24.
+
+-> **Note** The nodes and relationships are identified by the ` + "`uuid`" + ` property. ` +
+			`The provider warns if it's not indexed, because the reads scan the whole graph otherwise.`,
 		Attributes: map[string]schema.Attribute{
 			"db_uri": schema.StringAttribute{
 				MarkdownDescription: "Database access URI. " +
 					"Alternatively, set the environment variable `DB_URI`.",
 				Optional: true,
 			},
+			"fallback_uris": schema.ListAttribute{
+				MarkdownDescription: "The URIs tried in order when the database is not reachable by `db_uri`, " +
+					"e.g. the endpoints of the disaster recovery site. The same credentials are used for all URIs.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
 			"db_user": schema.StringAttribute{
 				MarkdownDescription: "The admin username to authenticated with the database. " +
 					"Alternatively, set the environment variable `DB_USER`.",
@@ -70,13 +130,132 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 			"db_password": schema.StringAttribute{
 				MarkdownDescription: "The user password to authenticated with the database. " +
 					"Alternatively, set the environment variable `DB_PASSWORD`.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"auth_disabled": schema.BoolAttribute{
+				MarkdownDescription: "Set to connect without the authentication, e.g. to the local development " +
+					"container started with `NEO4J_AUTH=none`. The `db_user` and `db_password` are ignored.",
 				Optional: true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("db_user"), path.MatchRoot("db_password")),
+				},
 			},
 			"db_name": schema.StringAttribute{
 				MarkdownDescription: "The database name. " +
 					"Alternatively, set the environment variable `DB_NAME`.",
 				Optional: true,
 			},
+			"max_concurrent_operations": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of the queries run concurrently by the provider. " +
+					"Set it to throttle large applies against small instances below the Terraform parallelism. " +
+					"The query occupies the slot until its records are fetched, and the transaction until it ends. " +
+					"Not limited if not set. The number of the running, waiting and cancelled operations " +
+					"is written to the provider's debug logs, e.g. to debug the pool exhaustion with `TF_LOG=DEBUG`.",
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"query_log_path": schema.StringAttribute{
+				MarkdownDescription: "The path to the file to append the queries run by the provider to, " +
+					"e.g. to archive the changes of the data for compliance. " +
+					"The queries are written as JSON lines with the time, the database, the query and its parameters. " +
+					"The queries are not logged if not set.",
+				Optional: true,
+			},
+			"query_log_params": schema.ListAttribute{
+				MarkdownDescription: "The names of the query parameters logged verbatim, " +
+					"the values of other parameters are redacted. The parameters set from the sensitive attributes, " +
+					"e.g. `password`, are always redacted.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"identity_strategy": schema.StringAttribute{
+				MarkdownDescription: "The strategy to generate the identifiers of the nodes and relationships: " +
+					"`uuid_v4` for the random UUIDs, or `uuid_v7` for the time-ordered UUIDs " +
+					"which keep the recently created entities close in the index. Defaults to `uuid_v4`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(identityStrategyUUIDv4, identityStrategyUUIDv7),
+				},
+			},
+			"role_users": schema.MapAttribute{
+				MarkdownDescription: "The users to impersonate to apply the changes of the resources " +
+					"with `execute_as_role`, keyed by the role, e.g. `{ editor = \"tf_editor\" }`. " +
+					"Every user shall be granted its role.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"label_sets": schema.MapAttribute{
+				MarkdownDescription: "The named sets of the labels added to the nodes by their `extra_labels_from`, " +
+					"e.g. `{ asset = [\"Asset\", \"Tracked\"] }` to compose the taxonomy " +
+					"without repeating the labels in every node.",
+				Optional:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+			},
+			"consistent_refresh": schema.BoolAttribute{
+				MarkdownDescription: "Set to read the nodes and the relationships on refresh at the bookmark " +
+					"taken when the first of them is read, i.e. every read sees at least the changes committed " +
+					"before the refresh, regardless of the cluster member it's routed to, " +
+					"e.g. to plan against the busy graph. The reads are run in the read transactions." +
+					"\n\n-> **Note** Neo4j has no snapshot reads across the transactions, " +
+					"hence the changes committed during the refresh may still be visible.",
+				Optional: true,
+			},
+			"transaction_guard_limit": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of the long-running transactions on the database. " +
+					"If more transactions run when the first change is applied, the apply waits for " +
+					"`transaction_guard_wait`, and is aborted if they don't finish, " +
+					"e.g. to avoid the pile-ups during the peak traffic. Not checked if not set.",
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"transaction_guard_threshold": schema.StringAttribute{
+				MarkdownDescription: "The elapsed time after which the transaction is counted as long-running, " +
+					"e.g. `1m`. Defaults to `30s`.",
+				Optional: true,
+				Validators: append([]validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("transaction_guard_limit")),
+				}, durationValidators...),
+			},
+			"transaction_guard_wait": schema.StringAttribute{
+				MarkdownDescription: "The maximum time to wait for the long-running transactions to finish, e.g. `5m`. " +
+					"The apply is aborted right away if not set.",
+				Optional: true,
+				Validators: append([]validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("transaction_guard_limit")),
+				}, durationValidators...),
+			},
+			"write_retry_max_time": schema.StringAttribute{
+				MarkdownDescription: "The maximum time to retry the writes of the nodes and the relationships " +
+					"failed by the transient errors, e.g. the deadlocks, or the leader switch in the cluster, " +
+					"e.g. `30s` for the small dev instance, or `5m` for the production cluster. " +
+					"The writes are not retried if not set.",
+				Optional:   true,
+				Validators: durationValidators,
+			},
+			"write_retry_base_delay": schema.StringAttribute{
+				MarkdownDescription: "The delay before the first retry of the write, e.g. `500ms`. " +
+					"It's doubled for every next retry up to `30s`. Defaults to `100ms`.",
+				Optional: true,
+				Validators: append([]validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("write_retry_max_time")),
+				}, durationValidators...),
+			},
+			"write_retry_jitter": schema.Float64Attribute{
+				MarkdownDescription: "The share of the delay it's randomized by to spread the concurrent retries, " +
+					"from 0 to 1, e.g. `0.5` for ±50%. Defaults to `0.2`.",
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.Between(0, 1),
+					float64validator.AlsoRequires(path.MatchRoot("write_retry_max_time")),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"driver":             driverBlock(),
+			"ownership_selector": ownershipSelectorBlock(),
+			"audit_log":          auditLogBlock(),
+			"webhook":            webhookBlock(),
 		},
 	}
 }
@@ -99,6 +278,7 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	if data.DatabasePassword.ValueString() == "" {
 		data.DatabasePassword = types.StringValue(os.Getenv("DB_PASSWORD"))
 	}
+	ctx = maskPassword(ctx, data.DatabasePassword.ValueString())
 	if data.DatabaseName.ValueString() == "" {
 		data.DatabaseName = types.StringValue(cmp.Or(os.Getenv("DB_NAME"), "neo4j"))
 	}
@@ -109,59 +289,671 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		return
 	}
 	resp.ResourceData = client
+	resp.DataSourceData = client
+	resp.EphemeralResourceData = client
+
+	if ok, err := client.hasIdentityIndex(ctx); err != nil {
+		tflog.Debug(ctx, "failed to check the identity index", map[string]interface{}{"error": err.Error()})
+	} else if !ok {
+		resp.Diagnostics.AddWarning("no index found for the identity property",
+			"The nodes and relationships are read by the `uuid` property which is not indexed, "+
+				"hence every read scans the whole graph. "+
+				"Create the index for the labels of the managed nodes, e.g. with the `neo4j_index` resource, or run\n\n"+
+				"CREATE INDEX node_uuid IF NOT EXISTS FOR (n:<Label>) ON (n.uuid)")
+	}
 }
 
-func NewClient(ctx context.Context, cfg ModelProvider) (sess neo4j.SessionWithContext, err error) {
-	driver, err := neo4j.NewDriverWithContext(cfg.DatabaseURI.ValueString(),
-		neo4j.BasicAuth(cfg.DatabaseUser.ValueString(), cfg.DatabasePassword.ValueString(), ""),
-	)
-	var isConnected bool
+// Client defines the database client shared by the resources and data sources.
+type Client struct {
+	neo4j.SessionWithContext
+
+	driver   neo4j.DriverWithContext
+	database string
+
+	// bookmarks chains the client's sessions, i.e. the reads in the client's session follow
+	// the writes run in the dedicated sessions.
+	bookmarks neo4j.BookmarkManager
+
+	// operations limits the number of the concurrently run queries, nil if not limited.
+	operations chan struct{}
+
+	// metrics counts the run operations, nil if not collected.
+	metrics *clientMetrics
+
+	// nodes coalesces the concurrent reads of the nodes.
+	nodes *readBatcher
+
+	// identityStrategy defines how the identifiers of the nodes and relationships are generated.
+	identityStrategy string
+
+	// roleUsers defines the users impersonated to apply the changes with the roles' privileges.
+	roleUsers map[string]string
+	// impersonatedUser is the user the queries are run on behalf of, empty for the provider's user.
+	impersonatedUser string
+	impersonated     *impersonatedClients
+
+	// labelSets defines the named sets of the labels added to the nodes.
+	labelSets map[string][]string
+
+	// refreshBookmark pins the reads of the refresh to the same bookmark, nil if not enabled.
+	refreshBookmark *refreshBookmark
+
+	// ownership defines the boundary of the managed subgraph, nil if not configured.
+	ownership *ownershipSelector
+
+	// auditLog writes the audit records of the applied changes, nil if not enabled.
+	auditLog *auditLog
+
+	// transactionGuard holds back the apply while too many transactions run long, nil if not configured.
+	transactionGuard *transactionGuard
+
+	// writeRetry retries the writes failed by the transient errors, nil if not configured.
+	writeRetry *writeRetry
+
+	// webhooks are notified about the applied changes of the nodes and the relationships.
+	webhooks []*webhook
+
+	// secrets masks the values of the sensitive attributes in the driver's logs.
+	secrets *secretMask
+
+	// queryLog writes the run queries, nil if not configured.
+	queryLog     *queryLogger
+	queryLogFile *os.File
+}
+
+// acquire blocks until the query can be run within the concurrency limit.
+// The returned function shall be called to release the acquired slot.
+func (c *Client) acquire(ctx context.Context) (release func(), err error) {
+	if c.operations != nil {
+		select {
+		case c.operations <- struct{}{}:
+		default:
+			c.metrics.waiting()
+			c.metrics.log(ctx, cap(c.operations), true)
+			select {
+			case c.operations <- struct{}{}:
+			case <-ctx.Done():
+				c.metrics.acquireFailed()
+				return nil, ctx.Err()
+			}
+		}
+	}
+	c.metrics.acquired()
+	return func() {
+		c.metrics.released()
+		if c.operations != nil {
+			<-c.operations
+		}
+		c.metrics.log(ctx, cap(c.operations), false)
+	}, nil
+}
+
+// Run executes the query within the concurrency limit.
+// The records are fetched before the slot is released, hence the limit covers the records' streaming.
+func (c *Client) Run(ctx context.Context, query string, params map[string]any,
+	configurers ...func(*neo4j.TransactionConfig)) (neo4j.ResultWithContext, error) {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	c.queryLog.log(c.database, query, params)
+	result, err := c.SessionWithContext.Run(ctx, query, params, configurers...)
+	if err != nil {
+		return nil, err
+	}
+	eager, err := newEagerResult(ctx, result)
+	if err != nil {
+		return nil, err
+	}
+	return eager, nil
+}
+
+// ExecuteRead executes the read transaction within the concurrency limit.
+func (c *Client) ExecuteRead(ctx context.Context, work neo4j.ManagedTransactionWork,
+	configurers ...func(*neo4j.TransactionConfig)) (any, error) {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return c.SessionWithContext.ExecuteRead(ctx, work, configurers...)
+}
+
+// ExecuteWrite executes the write transaction within the concurrency limit.
+func (c *Client) ExecuteWrite(ctx context.Context, work neo4j.ManagedTransactionWork,
+	configurers ...func(*neo4j.TransactionConfig)) (any, error) {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return c.SessionWithContext.ExecuteWrite(ctx, work, configurers...)
+}
+
+// eagerResult serves the records fetched when the query was run.
+// The consumed result is embedded to implement neo4j.ResultWithContext.
+type eagerResult struct {
+	neo4j.ResultWithContext
+	keys    []string
+	records []*neo4j.Record
+	record  *neo4j.Record
+	summary neo4j.ResultSummary
+}
+
+// newEagerResult fetches all records, and the summary of the result.
+func newEagerResult(ctx context.Context, result neo4j.ResultWithContext) (*eagerResult, error) {
+	keys, err := result.Keys()
+	if err != nil {
+		return nil, err
+	}
+	records, err := result.Collect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := result.Consume(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &eagerResult{ResultWithContext: result, keys: keys, records: records, summary: summary}, nil
+}
+
+func (r *eagerResult) Keys() ([]string, error) {
+	return r.keys, nil
+}
+
+func (r *eagerResult) NextRecord(ctx context.Context, record **neo4j.Record) bool {
+	ok := r.Next(ctx)
+	*record = r.record
+	return ok
+}
+
+func (r *eagerResult) Next(_ context.Context) bool {
+	if len(r.records) == 0 {
+		r.record = nil
+		return false
+	}
+	r.record, r.records = r.records[0], r.records[1:]
+	return true
+}
+
+func (r *eagerResult) PeekRecord(_ context.Context, record **neo4j.Record) bool {
+	if len(r.records) == 0 {
+		*record = nil
+		return false
+	}
+	*record = r.records[0]
+	return true
+}
+
+func (r *eagerResult) Peek(_ context.Context) bool {
+	return len(r.records) > 0
+}
+
+func (r *eagerResult) Err() error {
+	return nil
+}
+
+func (r *eagerResult) Record() *neo4j.Record {
+	return r.record
+}
+
+func (r *eagerResult) Collect(_ context.Context) ([]*neo4j.Record, error) {
+	records := r.records
+	r.records, r.record = nil, nil
+	return records, nil
+}
+
+func (r *eagerResult) Single(_ context.Context) (*neo4j.Record, error) {
+	records := r.records
+	r.records, r.record = nil, nil
+	switch len(records) {
+	case 0:
+		return nil, &neo4j.UsageError{Message: "Result contains no more records"}
+	case 1:
+		r.record = records[0]
+		return r.record, nil
+	default:
+		return nil, &neo4j.UsageError{Message: "Result contains more than one record"}
+	}
+}
+
+func (r *eagerResult) Consume(_ context.Context) (neo4j.ResultSummary, error) {
+	r.records, r.record = nil, nil
+	return r.summary, nil
+}
+
+func (r *eagerResult) IsOpen() bool {
+	return len(r.records) > 0
+}
+
+// newWriteSession opens the dedicated session to run the explicit write transaction,
+// because the client's session cannot run other queries while the transaction is open.
+// The caller shall close the session.
+func (c *Client) newWriteSession(ctx context.Context) neo4j.SessionWithContext {
+	return c.driver.NewSession(ctx, neo4j.SessionConfig{
+		DatabaseName:     c.database,
+		AccessMode:       neo4j.AccessModeWrite,
+		ImpersonatedUser: c.impersonatedUser,
+		BookmarkManager:  c.bookmarks,
+	})
+}
+
+// statement defines the query with its parameters.
+type statement struct {
+	query  string
+	params map[string]any
+}
+
+// RunPipelined executes the statements in a single write transaction, and returns their records.
+// All statements are sent before their results are consumed, hence the results are streamed
+// without the round trip to wait for every statement's completion, e.g. on high-latency links.
+// The transaction runs in the dedicated session, and it's rolled back if any statement fails.
+func (c *Client) RunPipelined(ctx context.Context, statements ...statement) (records [][]*neo4j.Record, err error) {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	session := c.newWriteSession(ctx)
+	defer func() {
+		ctx, cancel := cleanupContext(ctx)
+		defer cancel()
+		_ = session.Close(ctx)
+	}()
+
+	tx, err := session.BeginTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			ctx, cancel := cleanupContext(ctx)
+			defer cancel()
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	var results = make([]neo4j.ResultWithContext, len(statements))
+	for i, s := range statements {
+		c.queryLog.log(c.database, s.query, s.params)
+		if results[i], err = tx.Run(ctx, s.query, s.params); err != nil {
+			return nil, err
+		}
+	}
+	records = make([][]*neo4j.Record, len(results))
+	for i, result := range results {
+		if records[i], err = result.Collect(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// Close closes the session and the underlying driver.
+func (c *Client) Close(ctx context.Context) error {
+	err := errors.Join(c.closeImpersonated(ctx), c.SessionWithContext.Close(ctx), c.driver.Close(ctx))
+	if c.queryLogFile != nil {
+		err = errors.Join(err, c.queryLogFile.Close())
+	}
+	return err
+}
+
+// Explain validates the query by compiling its execution plan without running it.
+func (c *Client) Explain(ctx context.Context, query string, params map[string]any) error {
+	_, err := c.statementType(ctx, query, params)
+	return err
+}
+
+// statementType compiles the query's execution plan to define whether the query reads, or writes.
+func (c *Client) statementType(ctx context.Context, query string, params map[string]any) (neo4j.StatementType, error) {
+	dbResp, err := c.Run(ctx, "EXPLAIN "+query, params)
+	if err != nil {
+		return neo4j.StatementTypeUnknown, err
+	}
+	summary, err := dbResp.Consume(ctx)
+	if err != nil {
+		return neo4j.StatementTypeUnknown, err
+	}
+	return summary.StatementType(), nil
+}
+
+// RunRead executes the read-only query in the read transaction.
+// The query is rejected if it contains the write clauses. It sees the writes committed by the client.
+func (c *Client) RunRead(ctx context.Context, query string, params map[string]any) (*neo4j.EagerResult, error) {
+	statementType, err := c.statementType(ctx, query, params)
+	if err != nil {
+		return nil, err
+	}
+	if statementType != neo4j.StatementTypeReadOnly {
+		return nil, errors.New("the query shall only read the data, the write clauses are not allowed")
+	}
+
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	configurers := append(c.executeQueryConfigurers(c.database), neo4j.ExecuteQueryWithReadersRouting())
+	// The reader shall see the writes of the client's sessions, e.g. when the preconditions are checked on a cluster.
+	if c.bookmarks != nil {
+		configurers = append(configurers, neo4j.ExecuteQueryWithBookmarkManager(c.bookmarks))
+	}
+	c.queryLog.log(c.database, query, params)
+	return neo4j.ExecuteQuery(ctx, c.driver, query, params, neo4j.EagerResultTransformer, configurers...)
+}
+
+// RunSystem executes the query against the system database.
+// It's used to run the administration commands, e.g. `SHOW ALIASES`.
+func (c *Client) RunSystem(ctx context.Context, query string, params map[string]any) (*neo4j.EagerResult, error) {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	c.queryLog.log("system", query, params)
+	return neo4j.ExecuteQuery(ctx, c.driver, query, params, neo4j.EagerResultTransformer,
+		neo4j.ExecuteQueryWithDatabase("system"))
+}
+
+// RunReadOn executes the query in the read transaction against the given database,
+// e.g. against the composite database.
+func (c *Client) RunReadOn(ctx context.Context, database, query string,
+	params map[string]any) (*neo4j.EagerResult, error) {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	c.queryLog.log(database, query, params)
+	return neo4j.ExecuteQuery(ctx, c.driver, query, params, neo4j.EagerResultTransformer,
+		append(c.executeQueryConfigurers(database), neo4j.ExecuteQueryWithReadersRouting())...)
+}
+
+// newID generates the identifier of the node, or the relationship using the configured strategy.
+func (c *Client) newID() (string, error) {
+	if c != nil && c.identityStrategy == identityStrategyUUIDv7 {
+		id, err := uuid.NewV7()
+		if err != nil {
+			return "", err
+		}
+		return id.String(), nil
+	}
+	return uuid.NewString(), nil
+}
+
+// currentDatabase defines the name of the database managed by the provider.
+func (c *Client) currentDatabase(ctx context.Context) (string, error) {
+	if c.database != "" {
+		return c.database, nil
+	}
+	dbResp, err := c.Run(ctx, `CALL db.info() YIELD name RETURN name`, nil)
+	if err != nil {
+		return "", err
+	}
+	rec, err := dbResp.Single(ctx)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%v", rec.Values[0]), nil
+}
+
+// importID extracts the resource identifier from the import identifier of the format `[<database>/]<id>`.
+// The database shall match the database managed by the provider.
+func (c *Client) importID(ctx context.Context, id string) (string, error) {
+	database, resourceID, ok := strings.Cut(id, "/")
+	if !ok {
+		return id, nil
+	}
+	current, err := c.currentDatabase(ctx)
+	if err != nil {
+		return "", err
+	}
+	if database != current {
+		return "", fmt.Errorf("the resource belongs to the database %s, the provider manages the database %s",
+			database, current)
+	}
+	return resourceID, nil
+}
+
+// hasIdentityIndex checks if the identity property is indexed for any label, or relationship type.
+func (c *Client) hasIdentityIndex(ctx context.Context) (bool, error) {
+	dbResp, err := c.Run(ctx, `SHOW INDEXES YIELD type, properties
+WHERE type = "RANGE" AND properties = ["uuid"]
+RETURN count(*) > 0`, nil)
+	if err != nil {
+		return false, err
+	}
+	rec, err := dbResp.Single(ctx)
+	if err != nil {
+		return false, err
+	}
+	ok, _ := rec.Values[0].(bool)
+	return ok, nil
+}
+
+func NewClient(ctx context.Context, cfg ModelProvider) (c *Client, err error) {
+	var secrets = &secretMask{}
+	secrets.add(cfg.DatabasePassword.ValueString())
+	configurer, err := cfg.Driver.configurer(ctx, secrets)
+	if err != nil {
+		return nil, fmt.Errorf("invalid driver settings: %w", err)
+	}
+	var uris = []string{cfg.DatabaseURI.ValueString()}
+	if !cfg.FallbackURIs.IsNull() {
+		var fallbackURIs []string
+		if diags := cfg.FallbackURIs.ElementsAs(ctx, &fallbackURIs, false); diags.HasError() {
+			return nil, fmt.Errorf("failed to read the fallback URIs: %v", diags)
+		}
+		uris = append(uris, fallbackURIs...)
+	}
+	var auth = neo4j.BasicAuth(cfg.DatabaseUser.ValueString(), cfg.DatabasePassword.ValueString(), "")
+	if cfg.AuthDisabled.ValueBool() {
+		auth = neo4j.NoAuth()
+	}
+	driver, err := connect(ctx, uris, auth, configurer)
 	if err == nil {
-		if !isConnected {
-			if err = tryConnection(ctx, driver, 3); err == nil {
-				isConnected = true
+		bookmarks := neo4j.NewBookmarkManager(neo4j.BookmarkManagerConfig{})
+		c = &Client{
+			SessionWithContext: driver.NewSession(ctx, neo4j.SessionConfig{
+				DatabaseName:    cfg.DatabaseName.ValueString(),
+				BookmarkManager: bookmarks,
+			}),
+			driver:           driver,
+			bookmarks:        bookmarks,
+			database:         cfg.DatabaseName.ValueString(),
+			identityStrategy: cfg.IdentityStrategy.ValueString(),
+			secrets:          secrets,
+		}
+		c.metrics = &clientMetrics{}
+		if v := cfg.MaxConcurrentOperations.ValueInt64(); v > 0 {
+			c.operations = make(chan struct{}, v)
+		}
+		c.nodes = newNodeReadBatcher(c)
+		if cfg.ConsistentRefresh.ValueBool() {
+			c.refreshBookmark = &refreshBookmark{}
+		}
+
+		c.impersonated = &impersonatedClients{}
+		if !cfg.RoleUsers.IsNull() {
+			if diags := cfg.RoleUsers.ElementsAs(ctx, &c.roleUsers, false); diags.HasError() {
+				_ = c.Close(ctx)
+				return nil, fmt.Errorf("failed to read the role users: %v", diags)
+			}
+		}
+		if !cfg.LabelSets.IsNull() {
+			if diags := cfg.LabelSets.ElementsAs(ctx, &c.labelSets, false); diags.HasError() {
+				_ = c.Close(ctx)
+				return nil, fmt.Errorf("failed to read the label sets: %v", diags)
+			}
+		}
+
+		if c.ownership, err = newOwnershipSelector(ctx, cfg.OwnershipSelector); err != nil {
+			_ = c.Close(ctx)
+			return nil, err
+		}
+
+		if c.auditLog, err = newAuditLog(cfg.AuditLog, cfg.DatabaseUser.ValueString()); err != nil {
+			_ = c.Close(ctx)
+			return nil, err
+		}
+
+		if c.webhooks, err = newWebhooks(ctx, cfg.Webhooks); err != nil {
+			_ = c.Close(ctx)
+			return nil, err
+		}
+
+		if !cfg.TransactionGuardLimit.IsNull() {
+			if c.transactionGuard, err = newTransactionGuard(cfg.TransactionGuardLimit.ValueInt64(),
+				cfg.TransactionGuardThreshold.ValueString(), cfg.TransactionGuardWait.ValueString()); err != nil {
+				_ = c.Close(ctx)
+				return nil, err
+			}
+		}
+
+		if !cfg.WriteRetryMaxTime.IsNull() {
+			var jitter = writeRetryDefaultJitter
+			if !cfg.WriteRetryJitter.IsNull() {
+				jitter = cfg.WriteRetryJitter.ValueFloat64()
+			}
+			if c.writeRetry, err = newWriteRetry(cfg.WriteRetryMaxTime.ValueString(),
+				cfg.WriteRetryBaseDelay.ValueString(), jitter); err != nil {
+				_ = c.Close(ctx)
+				return nil, err
+			}
+		}
+
+		if path := cfg.QueryLogPath.ValueString(); path != "" {
+			var params []string
+			if !cfg.QueryLogParams.IsNull() {
+				if diags := cfg.QueryLogParams.ElementsAs(ctx, &params, false); diags.HasError() {
+					_ = c.Close(ctx)
+					return nil, fmt.Errorf("failed to read the logged query parameters: %v", diags)
+				}
+			}
+			if c.queryLog, c.queryLogFile, err = newQueryLogger(path, params); err != nil {
+				_ = c.Close(ctx)
+				return nil, fmt.Errorf("failed to open the query log: %w", err)
 			}
 		}
 	}
-	if isConnected {
-		sess = driver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: cfg.DatabaseName.ValueString()})
+	return c, err
+}
+
+// connect connects to the first reachable URI, i.e. the fallback URIs are tried in order if the primary one is down.
+func connect(ctx context.Context, uris []string, auth neo4j.AuthToken, configurer func(*neo4j.Config)) (
+	neo4j.DriverWithContext, error) {
+	var errs []error
+	for _, uri := range uris {
+		if ctx.Err() != nil {
+			errs = append(errs, ctx.Err())
+			break
+		}
+		driver, err := neo4j.NewDriverWithContext(uri, auth, configurer)
+		if err == nil {
+			if err = tryConnection(ctx, driver, 3); err == nil {
+				return driver, nil
+			}
+			_ = driver.Close(ctx)
+		}
+		tflog.Debug(ctx, "failed to connect to the database", map[string]interface{}{"uri": uri, "error": err.Error()})
+		errs = append(errs, fmt.Errorf("%s: %w", uri, err))
 	}
-	return sess, err
+	return nil, errors.Join(errs...)
 }
 
 func tryConnection(ctx context.Context, driver neo4j.DriverWithContext, maxAttempts uint8) error {
 	const (
 		delay = 1 * time.Second
 	)
-	var attempt uint8
 	var err error
-	for attempt < maxAttempts {
-		if err = driver.VerifyConnectivity(ctx); err == nil {
+	for attempt := uint8(1); attempt <= maxAttempts; attempt++ {
+		if err = driver.VerifyConnectivity(ctx); err == nil || attempt == maxAttempts {
 			break
 		}
-		time.Sleep(delay)
-		attempt++
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
 	}
 	return err
 }
 
+// cleanupTimeout defines the time to release the resources, e.g. to roll back the transaction.
+const cleanupTimeout = 10 * time.Second
+
+// cleanupContext defines the context to release the resources when the operation's context is cancelled,
+// otherwise the transaction is kept open on the server until the connection is closed.
+func cleanupContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), cleanupTimeout)
+}
+
 func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewNodeResource,
 		NewRelationshipResource,
+		NewLookupIndexResource,
+		NewIndexResource,
+		NewGDSGraphExportResource,
+		NewGraphMLExportResource,
+		NewJSONImportResource,
+		NewDVCatalogResource,
+		NewDatabaseGrantResource,
+		NewSecurityBaselineResource,
+		NewServerResource,
+		NewDatabaseResource,
+		NewDatabaseDefaultResource,
+		NewDatabaseTopologyResource,
+		NewDatabaseAliasResource,
+		NewDatabaseRemoteAliasResource,
+		NewPropertyRenameResource,
+		NewLabelRenameResource,
+		NewConsistencyCheckResource,
+		NewCypherScriptResource,
 	}
 }
 
 func (p *Provider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
-	return []func() ephemeral.EphemeralResource{}
+	return []func() ephemeral.EphemeralResource{
+		NewTemporaryCredentialsEphemeralResource,
+		NewGeneratedPasswordEphemeralResource,
+	}
 }
 
 func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewSettingsDataSource,
+		NewAliasesDataSource,
+		NewQueryExportDataSource,
+		NewTriggersDataSource,
+		NewCapabilitiesDataSource,
+		NewQueryDataSource,
+		NewNodeCountDataSource,
+		NewGraphChecksumDataSource,
+		NewGeneratedConfigDataSource,
+		NewUserDataSource,
+		NewRoleDataSource,
+		NewNodesByIDsDataSource,
+		NewTraversalDataSource,
+		NewIndexUsageDataSource,
+		NewServersDataSource,
+		NewDatabaseReadyDataSource,
+		NewCompositeQueryDataSource,
+		NewConsistencyCheckResultDataSource,
+		NewPingDataSource,
+		NewGraphSnapshotDataSource,
+	}
 }
 
 func (p *Provider) Functions(_ context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewGraphModelFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -6,11 +6,27 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
 	"log"
+	"reflect"
+	"regexp"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/testcontainers/testcontainers-go"
 	testContainerNeo4j "github.com/testcontainers/testcontainers-go/modules/neo4j"
 )
 
@@ -29,6 +45,12 @@ func init() {
 	c, err := testContainerNeo4j.Run(ctx,
 		"neo4j:5.26.0-community-ubi9",
 		testContainerNeo4j.WithLabsPlugin(testContainerNeo4j.Apoc),
+		// APOC reads its settings from apoc.conf, or from the environment variables, not from neo4j.conf.
+		testcontainers.WithEnv(map[string]string{
+			"APOC_EXPORT_FILE_ENABLED": "true",
+			"APOC_IMPORT_FILE_ENABLED": "true",
+			"APOC_TRIGGER_ENABLED":     "true",
+		}),
 	)
 	if err != nil {
 		log.Fatalf("failed to start a neo4j container: %v\n", err)
@@ -49,3 +71,363 @@ func init() {
 		_ = c.Terminate(context.Background())
 	}
 }
+
+func TestClientAcquire(t *testing.T) {
+	c := &Client{operations: make(chan struct{}, 1)}
+
+	release, err := c.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.acquire(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the context cancelled error when the limit is reached, got: %v", err)
+	}
+
+	release()
+	if len(c.operations) != 0 {
+		t.Fatal("the slot shall be released")
+	}
+
+	if _, err := (&Client{}).acquire(ctx); err != nil {
+		t.Fatalf("unexpected error for the client without limit: %v", err)
+	}
+}
+
+func TestClientExecuteWithinLimit(t *testing.T) {
+	c := &Client{operations: make(chan struct{}, 1)}
+	release, err := c.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer release()
+
+	// the transactions wait for the slot, hence they fail before the session is used
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	work := func(neo4j.ManagedTransaction) (any, error) { return nil, nil }
+	if _, err := c.ExecuteRead(ctx, work); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the read to wait for the slot, got: %v", err)
+	}
+	if _, err := c.ExecuteWrite(ctx, work); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the write to wait for the slot, got: %v", err)
+	}
+	if _, err := c.Run(ctx, "RETURN 1", nil); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the query to wait for the slot, got: %v", err)
+	}
+}
+
+func TestEagerResult(t *testing.T) {
+	ctx := context.Background()
+	first, second := &neo4j.Record{Values: []any{int64(1)}}, &neo4j.Record{Values: []any{int64(2)}}
+
+	r := &eagerResult{keys: []string{"i"}, records: []*neo4j.Record{first, second}}
+	if keys, err := r.Keys(); err != nil || !reflect.DeepEqual(keys, []string{"i"}) {
+		t.Fatalf("unexpected keys: %v, error: %v", keys, err)
+	}
+	var rec *neo4j.Record
+	if !r.PeekRecord(ctx, &rec) || rec != first {
+		t.Fatalf("expected to peek the first record, got: %v", rec)
+	}
+	if !r.NextRecord(ctx, &rec) || rec != first {
+		t.Fatalf("expected the first record, got: %v", rec)
+	}
+	if !r.Next(ctx) || r.Record() != second {
+		t.Fatalf("expected the second record, got: %v", r.Record())
+	}
+	if r.NextRecord(ctx, &rec) || rec != nil || r.Err() != nil {
+		t.Fatalf("expected no more records, got: %v, error: %v", rec, r.Err())
+	}
+
+	r = &eagerResult{records: []*neo4j.Record{first}}
+	if rec, err := r.Single(ctx); err != nil || rec != first {
+		t.Fatalf("expected the single record, got: %v, error: %v", rec, err)
+	}
+	if _, err := r.Single(ctx); err == nil {
+		t.Fatal("expected the error when no records are left")
+	}
+	r = &eagerResult{records: []*neo4j.Record{first, second}}
+	if _, err := r.Single(ctx); err == nil {
+		t.Fatal("expected the error when more than one record is left")
+	}
+
+	r = &eagerResult{records: []*neo4j.Record{first, second}}
+	if records, err := r.Collect(ctx); err != nil || len(records) != 2 || r.Peek(ctx) {
+		t.Fatalf("expected all records to be collected, got: %v, error: %v", records, err)
+	}
+}
+
+func TestClientHasIdentityIndex(t *testing.T) {
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err != nil {
+		t.Fatalf("could not connect to database: %v", err)
+	}
+	t.Cleanup(func() {
+		_, _ = c.Run(ctx, "DROP INDEX test_identity IF EXISTS", nil)
+		_ = c.Close(ctx)
+	})
+
+	if ok, err := c.hasIdentityIndex(ctx); err != nil || ok {
+		t.Fatalf("expected no identity index, got: %v, error: %v", ok, err)
+	}
+
+	if _, err := c.Run(ctx, "CREATE INDEX test_identity FOR (n:Person) ON (n.uuid)", nil); err != nil {
+		t.Fatalf("failed to create the index: %v", err)
+	}
+
+	if ok, err := c.hasIdentityIndex(ctx); err != nil || !ok {
+		t.Fatalf("expected the identity index, got: %v, error: %v", ok, err)
+	}
+}
+
+// factories lists the names of the functions declared in the package which match the pattern.
+func factories(t *testing.T, pattern *regexp.Regexp) []string {
+	t.Helper()
+	pkgs, err := parser.ParseDir(token.NewFileSet(), ".", func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatalf("failed to parse the package: %v", err)
+	}
+	var o []string
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && pattern.MatchString(fn.Name.Name) {
+					o = append(o, fn.Name.Name)
+				}
+			}
+		}
+	}
+	slices.Sort(o)
+	return o
+}
+
+// funcName returns the name of the package function.
+func funcName(f any) string {
+	name := runtime.FuncForPC(reflect.ValueOf(f).Pointer()).Name()
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+func TestProviderRegistration(t *testing.T) {
+	ctx := context.Background()
+	p := &Provider{}
+
+	var resources []string
+	for _, f := range p.Resources(ctx) {
+		resources = append(resources, funcName(f))
+	}
+	slices.Sort(resources)
+	want := slices.DeleteFunc(factories(t, regexp.MustCompile(`^New\w+Resource$`)), func(name string) bool {
+		return strings.HasSuffix(name, "EphemeralResource")
+	})
+	if !slices.Equal(want, resources) {
+		t.Errorf("unexpected resources registered, want: %v, got: %v", want, resources)
+	}
+
+	var dataSources []string
+	for _, f := range p.DataSources(ctx) {
+		dataSources = append(dataSources, funcName(f))
+	}
+	slices.Sort(dataSources)
+	if want := factories(t, regexp.MustCompile(`^New\w+DataSource$`)); !slices.Equal(want, dataSources) {
+		t.Errorf("unexpected data sources registered, want: %v, got: %v", want, dataSources)
+	}
+
+	var ephemeralResources []string
+	for _, f := range p.EphemeralResources(ctx) {
+		ephemeralResources = append(ephemeralResources, funcName(f))
+	}
+	slices.Sort(ephemeralResources)
+	if want := factories(t, regexp.MustCompile(`^New\w+EphemeralResource$`)); !slices.Equal(want, ephemeralResources) {
+		t.Errorf("unexpected ephemeral resources registered, want: %v, got: %v", want, ephemeralResources)
+	}
+
+	var functions []string
+	for _, f := range p.Functions(ctx) {
+		functions = append(functions, funcName(f))
+	}
+	slices.Sort(functions)
+	if want := factories(t, regexp.MustCompile(`^New\w+Function$`)); !slices.Equal(want, functions) {
+		t.Errorf("unexpected functions registered, want: %v, got: %v", want, functions)
+	}
+}
+
+func TestClientNewID(t *testing.T) {
+	id, err := (&Client{}).newID()
+	if err != nil || uuid.MustParse(id).Version() != 4 {
+		t.Fatalf("expected UUID v4 by default, got: %s, error: %v", id, err)
+	}
+
+	c := &Client{identityStrategy: identityStrategyUUIDv7}
+	first, err := c.newID()
+	if err != nil || uuid.MustParse(first).Version() != 7 {
+		t.Fatalf("expected UUID v7, got: %s, error: %v", first, err)
+	}
+	second, _ := c.newID()
+	if second <= first {
+		t.Fatalf("expected the time-ordered identifiers, got: %s after %s", second, first)
+	}
+}
+
+func TestClientRunPipelined(t *testing.T) {
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err != nil {
+		t.Fatalf("could not conenct to database: %v\n", err)
+	}
+	t.Cleanup(func() { _ = c.Close(ctx) })
+	t.Cleanup(func() {
+		_, _ = c.Run(ctx, `MATCH (n:Pipelined) DELETE n`, nil)
+	})
+
+	records, err := c.RunPipelined(ctx,
+		statement{query: `CREATE (n:Pipelined{id: $id}) RETURN n.id`, params: map[string]any{"id": 1}},
+		statement{query: `UNWIND range(1, 3) AS i RETURN i`},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(records) != 2 || len(records[0]) != 1 || len(records[1]) != 3 {
+		t.Fatalf("expected the records of every statement, got: %v", records)
+	}
+
+	// the transaction is rolled back if any statement fails
+	if _, err = c.RunPipelined(ctx,
+		statement{query: `CREATE (n:Pipelined{id: 2})`},
+		statement{query: `RETURN 1/0`},
+	); err == nil {
+		t.Fatal("expected the error of the failed statement")
+	}
+	dbResp, err := c.Run(ctx, `MATCH (n:Pipelined) RETURN count(n)`, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rec, err := dbResp.Single(ctx)
+	if err != nil || rec.Values[0].(int64) != 1 {
+		t.Fatalf("expected the failed transaction to be rolled back, got: %v, error: %v", rec, err)
+	}
+}
+
+func TestNewClientDriverConfig(t *testing.T) {
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+		Driver: &ModelDriver{
+			TelemetryDisabled: types.BoolValue(true),
+			FetchSize:         types.Int64Value(1),
+		},
+	})
+	if err != nil {
+		t.Fatalf("could not conenct to database: %v\n", err)
+	}
+	t.Cleanup(func() { _ = c.Close(ctx) })
+
+	// the records are streamed in several batches
+	dbResp, err := c.Run(ctx, `UNWIND range(1, 3) AS i RETURN i`, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	records, err := dbResp.Collect(ctx)
+	if err != nil || len(records) != 3 {
+		t.Fatalf("expected 3 records, got: %d, error: %v", len(records), err)
+	}
+}
+
+func TestNewClientFallbackURIs(t *testing.T) {
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue("bolt://localhost:1"),
+		FallbackURIs:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue(testDbURI)}),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err != nil {
+		t.Fatalf("expected to connect to the fallback URI, got: %v", err)
+	}
+	_ = c.Close(ctx)
+
+	_, err = NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue("bolt://localhost:1"),
+		FallbackURIs:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("bolt://localhost:2")}),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err == nil || !strings.Contains(err.Error(), "bolt://localhost:1") ||
+		!strings.Contains(err.Error(), "bolt://localhost:2") {
+		t.Fatalf("expected the errors of all URIs, got: %v", err)
+	}
+}
+
+func TestTryConnectionCancelled(t *testing.T) {
+	driver, err := neo4j.NewDriverWithContext("bolt://localhost:1", neo4j.NoAuth())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() { _ = driver.Close(context.Background()) })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err = tryConnection(ctx, driver, 10)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the context error, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected to abort the retries promptly, took: %s", elapsed)
+	}
+}
+
+func TestClientRunPipelinedCancelled(t *testing.T) {
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err != nil {
+		t.Fatalf("could not conenct to database: %v\n", err)
+	}
+	t.Cleanup(func() { _ = c.Close(ctx) })
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err = c.RunPipelined(cancelledCtx, statement{query: `CREATE (:TestPipelinedCancelled)`}); err == nil {
+		t.Fatal("expected the error when the context is cancelled")
+	}
+
+	// the session is usable after the cancelled transaction
+	dbResp, err := c.Run(ctx, `MATCH (n:TestPipelinedCancelled) RETURN count(n)`, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rec, err := dbResp.Single(ctx)
+	if err != nil || rec.Values[0].(int64) != 0 {
+		t.Fatalf("expected no nodes created, got: %v, error: %v", rec, err)
+	}
+}
+
+func TestNewClientAuthDisabled(t *testing.T) {
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:  types.StringValue(testDbURI),
+		AuthDisabled: types.BoolValue(true),
+	})
+	if err != nil {
+		t.Fatalf("could not connect to the database without the authentication: %v", err)
+	}
+	_ = c.Close(ctx)
+}
@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &QueryDataSource{}
+
+func NewQueryDataSource() datasource.DataSource {
+	return &QueryDataSource{}
+}
+
+// QueryDataSource defines the data source to read the Cypher query results.
+type QueryDataSource struct {
+	client *Client
+}
+
+// QueryDataSourceModel describes the data source data model.
+type QueryDataSourceModel struct {
+	Query   types.String  `tfsdk:"query"`
+	Params  types.Map     `tfsdk:"params"`
+	Columns types.Map     `tfsdk:"columns"`
+	Rows    types.Dynamic `tfsdk:"rows"`
+}
+
+const (
+	querySuffix = "_query"
+
+	columnTypeString = "string"
+	columnTypeNumber = "number"
+	columnTypeBool   = "bool"
+	columnTypeList   = "list"
+)
+
+// columnAttrType defines the Terraform type of the column's values.
+func columnAttrType(columnType string) attr.Type {
+	switch columnType {
+	case columnTypeNumber:
+		return types.NumberType
+	case columnTypeBool:
+		return types.BoolType
+	case columnTypeList:
+		return types.ListType{ElemType: types.StringType}
+	default:
+		return types.StringType
+	}
+}
+
+// toColumnValue converts the value returned by the database to the Terraform value of the column's type.
+func toColumnValue(v any, columnType string) (attr.Value, error) {
+	if v == nil {
+		switch columnType {
+		case columnTypeNumber:
+			return types.NumberNull(), nil
+		case columnTypeBool:
+			return types.BoolNull(), nil
+		case columnTypeList:
+			return types.ListNull(types.StringType), nil
+		default:
+			return types.StringNull(), nil
+		}
+	}
+
+	switch columnType {
+	case columnTypeNumber:
+		switch n := v.(type) {
+		case int64:
+			return types.NumberValue(new(big.Float).SetInt64(n)), nil
+		case float64:
+			return types.NumberValue(big.NewFloat(n)), nil
+		}
+	case columnTypeBool:
+		if b, ok := v.(bool); ok {
+			return types.BoolValue(b), nil
+		}
+	case columnTypeList:
+		if l, ok := v.([]any); ok {
+			var elements = make([]attr.Value, len(l))
+			for i, el := range l {
+				elements[i] = toStringValue(el)
+			}
+			return types.ListValueMust(types.StringType, elements), nil
+		}
+	default:
+		return toStringValue(v), nil
+	}
+	return nil, fmt.Errorf("cannot convert %v of type %T to %s", v, v, columnType)
+}
+
+func (d *QueryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + querySuffix
+}
+
+func (d *QueryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the Cypher query results." +
+			"\n\n-> **Note** The query runs in the read transaction, the queries with the write clauses are rejected.",
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Cypher query to read the results of.",
+				Required:            true,
+			},
+			"params": schema.MapAttribute{
+				MarkdownDescription: "The query parameters.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"columns": schema.MapAttribute{
+				MarkdownDescription: "The expected columns and their types: `string`, `number`, `bool`, or `list`. " +
+					"The `list` columns are exposed as the lists of strings. " +
+					"All returned columns are exposed as strings if not set.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(
+						stringvalidator.OneOf(columnTypeString, columnTypeNumber, columnTypeBool, columnTypeList),
+					),
+				},
+			},
+			"rows": schema.DynamicAttribute{
+				MarkdownDescription: "The list of the returned rows, the objects with the values of the typed columns.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *QueryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *QueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data QueryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var params, columns map[string]string
+	resp.Diagnostics.Append(data.Params.ElementsAs(ctx, &params, false)...)
+	resp.Diagnostics.Append(data.Columns.ElementsAs(ctx, &columns, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "reading the query results")
+
+	var queryParams = make(map[string]any, len(params))
+	for k, v := range params {
+		queryParams[k] = v
+	}
+
+	dbResp, err := d.client.RunRead(ctx, data.Query.ValueString(), queryParams)
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the query results")
+		resp.Diagnostics.AddError("failed to read the query results", err.Error())
+		return
+	}
+
+	if len(columns) == 0 {
+		columns = make(map[string]string, len(dbResp.Keys))
+		for _, k := range dbResp.Keys {
+			columns[k] = columnTypeString
+		}
+	}
+
+	var attrTypes = make(map[string]attr.Type, len(columns))
+	for k, t := range columns {
+		attrTypes[k] = columnAttrType(t)
+	}
+	rowType := types.ObjectType{AttrTypes: attrTypes}
+
+	var rows = make([]attr.Value, 0, len(dbResp.Records))
+	for _, rec := range dbResp.Records {
+		var values = make(map[string]attr.Value, len(columns))
+		for k, t := range columns {
+			v, ok := rec.Get(k)
+			if !ok {
+				resp.Diagnostics.AddError("unexpected query results", fmt.Sprintf("the column %s is not returned", k))
+				return
+			}
+			if values[k], err = toColumnValue(v, t); err != nil {
+				resp.Diagnostics.AddError("unexpected query results", fmt.Sprintf("column %s: %v", k, err))
+				return
+			}
+		}
+		row, diags := types.ObjectValue(attrTypes, values)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		rows = append(rows, row)
+	}
+
+	list, diags := types.ListValue(rowType, rows)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Rows = types.DynamicValue(list)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the query results", map[string]interface{}{"count": len(rows)})
+}
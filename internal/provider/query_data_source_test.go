@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"math/big"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestToColumnValue(t *testing.T) {
+	tests := map[string]struct {
+		v          any
+		columnType string
+		want       attr.Value
+		wantErr    bool
+	}{
+		"int to number": {
+			v: int64(42), columnType: columnTypeNumber,
+			want: types.NumberValue(big.NewFloat(42)),
+		},
+		"float to number": {
+			v: 0.5, columnType: columnTypeNumber,
+			want: types.NumberValue(big.NewFloat(0.5)),
+		},
+		"bool": {
+			v: true, columnType: columnTypeBool,
+			want: types.BoolValue(true),
+		},
+		"list": {
+			v: []any{"a", int64(1)}, columnType: columnTypeList,
+			want: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("1")}),
+		},
+		"int to string": {
+			v: int64(1), columnType: columnTypeString,
+			want: types.StringValue("1"),
+		},
+		"null number": {
+			v: nil, columnType: columnTypeNumber,
+			want: types.NumberNull(),
+		},
+		"string to number": {
+			v: "foo", columnType: columnTypeNumber,
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := toColumnValue(tt.v, tt.columnType)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccQueryDataSource(t *testing.T) {
+	t.Setenv("DB_URI", testDbURI)
+	t.Setenv("DB_USER", testDBUser)
+	t.Cleanup(func() {
+		t.Setenv("DB_URI", "")
+		t.Setenv("DB_USER", "")
+	})
+
+	const dataSourceAddress = "data." + Name + querySuffix + "._"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "neo4j_query" "_" {
+query   = "UNWIND [1, 2] AS n RETURN n, n > 1 AS big, [$prefix + n] AS names"
+params  = { prefix = "name-" }
+columns = { n = "number", big = "bool", names = "list" }
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("rows"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"n":     knownvalue.Int64Exact(1),
+								"big":   knownvalue.Bool(false),
+								"names": knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("name-1")}),
+							}),
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"n":     knownvalue.Int64Exact(2),
+								"big":   knownvalue.Bool(true),
+								"names": knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("name-2")}),
+							}),
+						})),
+				},
+			},
+			{
+				Config: `data "neo4j_query" "_" {
+query = "MERGE (n:QueryDataSource) RETURN n.uuid AS uuid"
+}`,
+				ExpectError: regexp.MustCompile(`write clauses are not allowed`),
+			},
+			{
+				Config: `data "neo4j_query" "_" {
+query = "RETURN 1 AS n"
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("rows"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"n": knownvalue.StringExact("1"),
+							}),
+						})),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &QueryExportDataSource{}
+
+func NewQueryExportDataSource() datasource.DataSource {
+	return &QueryExportDataSource{}
+}
+
+// QueryExportDataSource defines the data source to export the query results to CSV, or JSON.
+type QueryExportDataSource struct {
+	client *Client
+}
+
+// QueryExportDataSourceModel describes the data source data model.
+type QueryExportDataSourceModel struct {
+	Query  types.String `tfsdk:"query"`
+	Format types.String `tfsdk:"format"`
+	File   types.String `tfsdk:"file"`
+	Data   types.String `tfsdk:"data"`
+	Rows   types.Int64  `tfsdk:"rows"`
+}
+
+const (
+	queryExportSuffix = "_query_export"
+
+	exportFormatCSV  = "csv"
+	exportFormatJSON = "json"
+)
+
+func (d *QueryExportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + queryExportSuffix
+}
+
+func (d *QueryExportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Exports the Cypher query results to CSV, or JSON using APOC, details: " +
+			"https://neo4j.com/docs/apoc/current/export/" +
+			"\n\n!>**Warning** The data source requires the APOC plugin. " +
+			"The export to file requires `apoc.export.file.enabled=true`.",
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Cypher query to export the results of.",
+				Required:            true,
+			},
+			"format": schema.StringAttribute{
+				MarkdownDescription: "Export format: `csv`, or `json`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(exportFormatCSV, exportFormatJSON),
+				},
+			},
+			"file": schema.StringAttribute{
+				MarkdownDescription: "The path, or URL, of the file to export the results to. " +
+					"The results are exposed as `data` if not set.",
+				Optional: true,
+			},
+			"data": schema.StringAttribute{
+				MarkdownDescription: "The exported payload. It's null when the results are exported to file.",
+				Computed:            true,
+			},
+			"rows": schema.Int64Attribute{
+				MarkdownDescription: "The number of exported rows.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *QueryExportDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *QueryExportDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+	var data QueryExportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	props := map[string]interface{}{"format": data.Format.ValueString(), "file": data.File.ValueString()}
+	tflog.Trace(ctx, "export the query results", props)
+
+	// The format is validated by the schema, hence it's safe to embed it to the procedure name.
+	query := `CALL apoc.export.` + data.Format.ValueString() + `.query($query, $file, {stream: $stream})
+YIELD rows, data
+RETURN rows, data`
+	var params = map[string]any{
+		"query":  data.Query.ValueString(),
+		"file":   nil,
+		"stream": data.File.IsNull(),
+	}
+	if !data.File.IsNull() {
+		params["file"] = data.File.ValueString()
+	}
+
+	dbResp, err := d.client.Run(ctx, query, params)
+	if err != nil {
+		tflog.Debug(ctx, "failed to export the query results", props)
+		resp.Diagnostics.AddError("failed to export the query results", err.Error())
+		return
+	}
+
+	// The streamed export is split to batches, one per record, the rows count is accumulated by APOC.
+	var payload strings.Builder
+	var rows int64
+	var rec *neo4j.Record
+	for dbResp.NextRecord(ctx, &rec) {
+		m := rec.AsMap()
+		if v, ok := m["rows"].(int64); ok {
+			rows = max(rows, v)
+		}
+		if v, ok := m["data"].(string); ok {
+			payload.WriteString(v)
+		}
+	}
+	if err := dbResp.Err(); err != nil {
+		tflog.Debug(ctx, "failed to export the query results", props)
+		resp.Diagnostics.AddError("failed to export the query results", err.Error())
+		return
+	}
+
+	data.Rows = types.Int64Value(rows)
+	data.Data = types.StringNull()
+	if data.File.IsNull() {
+		data.Data = types.StringValue(payload.String())
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "exported the query results", props)
+}
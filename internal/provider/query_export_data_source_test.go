@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccQueryExportDataSource(t *testing.T) {
+	t.Setenv("DB_URI", testDbURI)
+	t.Setenv("DB_USER", testDBUser)
+	t.Cleanup(func() {
+		t.Setenv("DB_URI", "")
+		t.Setenv("DB_USER", "")
+	})
+
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err != nil {
+		t.Errorf("could not conenct to database: %v\n", err)
+		return
+	}
+	t.Cleanup(func() { _ = c.Close(ctx) })
+
+	if _, err = c.Run(ctx, `CREATE (:QueryExport{uuid:"export-0"}), (:QueryExport{uuid:"export-1"})`,
+		nil); err != nil {
+		t.Errorf("could not seed the graph: %v\n", err)
+		return
+	}
+	t.Cleanup(func() {
+		_, _ = c.Run(ctx, `MATCH (n:QueryExport) DETACH DELETE n`, nil)
+	})
+
+	const dataSourceAddress = "data." + Name + queryExportSuffix + "._"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "neo4j_query_export" "_" {
+query  = "MATCH (n:QueryExport) RETURN n.uuid AS uuid ORDER BY uuid"
+format = "csv"
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("rows"),
+						knownvalue.Int64Exact(2)),
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("data"),
+						knownvalue.StringRegexp(regexp.MustCompile(`(?s)uuid.*export-0.*export-1`))),
+				},
+			},
+			{
+				Config: `data "neo4j_query_export" "_" {
+query  = "MATCH (n:QueryExport) RETURN n.uuid AS uuid ORDER BY uuid"
+format = "json"
+file   = "query-export.json"
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("rows"),
+						knownvalue.Int64Exact(2)),
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("data"),
+						knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
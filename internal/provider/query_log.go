@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"slices"
+	"sync"
+	"time"
+)
+
+// redacted replaces the values of the parameters which are not allowed to be logged.
+const redacted = "[REDACTED]"
+
+// queryLogger writes the executed queries as JSON lines.
+type queryLogger struct {
+	mu sync.Mutex
+	w  io.Writer
+
+	// params defines the parameters logged verbatim, the values of other parameters are redacted.
+	params []string
+}
+
+// queryLogEntry describes the logged query.
+type queryLogEntry struct {
+	Time     time.Time      `json:"time"`
+	Database string         `json:"database"`
+	Query    string         `json:"query"`
+	Params   map[string]any `json:"params,omitempty"`
+}
+
+// newQueryLogger opens the file to append the queries to.
+func newQueryLogger(path string, params []string) (*queryLogger, *os.File, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &queryLogger{w: f, params: params}, f, nil
+}
+
+// log writes the query, it's no-op if the logger is not defined.
+func (l *queryLogger) log(database, query string, params map[string]any) {
+	if l == nil {
+		return
+	}
+
+	var entry = queryLogEntry{
+		Time:     time.Now().UTC(),
+		Database: database,
+		Query:    query,
+	}
+	if len(params) > 0 {
+		entry.Params = make(map[string]any, len(params))
+		for k, v := range params {
+			if slices.Contains(l.params, k) && !slices.Contains(sensitiveParams, k) {
+				entry.Params[k] = v
+			} else {
+				entry.Params[k] = redacted
+			}
+		}
+	}
+
+	o, err := json.Marshal(entry)
+	if err != nil {
+		// The values which cannot be encoded are not logged to keep the query in the log.
+		entry.Params = nil
+		o, _ = json.Marshal(entry)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, _ = l.w.Write(append(o, '\n'))
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryLogger(t *testing.T) {
+	var buf bytes.Buffer
+	l := &queryLogger{w: &buf, params: []string{"uuid", "password"}}
+
+	l.log("neo4j", "MATCH (n{uuid:$uuid}) SET n.secret = $secret", map[string]any{
+		"uuid":   "foo",
+		"secret": "bar",
+	})
+	l.log("system", "CREATE USER $name SET PASSWORD $password", map[string]any{"password": "baz"})
+	l.log("system", "SHOW USERS", nil)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if !assert.Len(t, lines, 3) {
+		return
+	}
+
+	var entry queryLogEntry
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+	assert.Equal(t, "neo4j", entry.Database)
+	assert.Equal(t, "MATCH (n{uuid:$uuid}) SET n.secret = $secret", entry.Query)
+	assert.Equal(t, map[string]any{"uuid": "foo", "secret": redacted}, entry.Params)
+	assert.False(t, entry.Time.IsZero())
+
+	// the sensitive parameters are redacted even if they're listed
+	entry = queryLogEntry{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[1]), &entry))
+	assert.Equal(t, map[string]any{"password": redacted}, entry.Params)
+
+	entry = queryLogEntry{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[2]), &entry))
+	assert.Equal(t, "system", entry.Database)
+	assert.Nil(t, entry.Params)
+
+	// the queries are not logged if the logger is not configured
+	var nilLogger *queryLogger
+	nilLogger.log("neo4j", "RETURN 1", nil)
+}
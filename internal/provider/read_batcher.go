@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// readBatchWindow defines the time to wait for the concurrent reads to coalesce them into a single query.
+const readBatchWindow = 10 * time.Millisecond
+
+// readBatcher coalesces the reads of the entities by uuid issued within a short time window into a single query.
+// It cuts the refresh time when Terraform reads hundreds of resources in parallel.
+type readBatcher struct {
+	window time.Duration
+	// run reads the entities by their uuid, the missing entities are not included in the output.
+	run func(ctx context.Context, ids []string) (map[string]any, error)
+
+	mu      sync.Mutex
+	pending map[string][]chan readResult
+}
+
+type readResult struct {
+	value any
+	found bool
+	err   error
+}
+
+// get returns the entity read in the batch with the concurrent requests.
+func (b *readBatcher) get(ctx context.Context, id string) (v any, found bool, err error) {
+	ch := make(chan readResult, 1)
+
+	b.mu.Lock()
+	if b.pending == nil {
+		b.pending = map[string][]chan readResult{}
+		// The batch shall not be aborted when the first of its requesters is cancelled.
+		batchCtx := context.WithoutCancel(ctx)
+		time.AfterFunc(b.window, func() { b.flush(batchCtx) })
+	}
+	b.pending[id] = append(b.pending[id], ch)
+	b.mu.Unlock()
+
+	select {
+	case r := <-ch:
+		return r.value, r.found, r.err
+	case <-ctx.Done():
+		return nil, false, ctx.Err()
+	}
+}
+
+func (b *readBatcher) flush(ctx context.Context) {
+	b.mu.Lock()
+	pending := b.pending
+	b.pending = nil
+	b.mu.Unlock()
+
+	var ids = make([]string, 0, len(pending))
+	for id := range pending {
+		ids = append(ids, id)
+	}
+
+	values, err := b.run(ctx, ids)
+	for id, chs := range pending {
+		v, found := values[id]
+		for _, ch := range chs {
+			ch <- readResult{value: v, found: found, err: err}
+		}
+	}
+}
+
+// newNodeReadBatcher defines the batcher to read the nodes.
+func newNodeReadBatcher(c *Client) *readBatcher {
+	return &readBatcher{
+		window: readBatchWindow,
+		run: func(ctx context.Context, ids []string) (map[string]any, error) {
+			records, err := c.runRefresh(ctx, `UNWIND $ids AS id MATCH (n{uuid:id}) RETURN id, n`,
+				map[string]any{"ids": ids})
+			if err != nil {
+				return nil, err
+			}
+			var o = make(map[string]any, len(ids))
+			for _, rec := range records {
+				if id, ok := rec.Values[0].(string); ok {
+					o[id] = rec.Values[1]
+				}
+			}
+			return o, nil
+		},
+	}
+}
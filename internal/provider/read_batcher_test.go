@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestReadBatcher(t *testing.T) {
+	var calls atomic.Int32
+	b := &readBatcher{
+		window: 50 * time.Millisecond,
+		run: func(_ context.Context, ids []string) (map[string]any, error) {
+			calls.Add(1)
+			var o = map[string]any{}
+			for _, id := range ids {
+				if id != "missing" {
+					o[id] = "value-" + id
+				}
+			}
+			return o, nil
+		},
+	}
+
+	ids := []string{"a", "b", "c", "a", "missing"}
+	var wg sync.WaitGroup
+	var errs = make([]string, len(ids))
+	for i, id := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, found, err := b.get(context.Background(), id)
+			switch {
+			case err != nil:
+				errs[i] = err.Error()
+			case id == "missing" && found:
+				errs[i] = "unexpected node found"
+			case id != "missing" && v != "value-"+id:
+				errs[i] = "unexpected value"
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != "" {
+			t.Errorf("%s: %s", ids[i], err)
+		}
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected the reads to be coalesced into a single query, got %d queries", got)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// sensitiveParams defines the query parameters bound to the sensitive attributes.
+// They're redacted in the query log even if they're listed in the query_log_params.
+var sensitiveParams = []string{"password", "seedCredentials"}
+
+// secretMask masks the values of the sensitive attributes in the driver's logs.
+type secretMask struct {
+	mu     sync.RWMutex
+	values []string
+}
+
+// add registers the values to mask, the empty values are skipped.
+func (m *secretMask) add(values ...string) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, v := range values {
+		if v != "" && !slices.Contains(m.values, v) {
+			m.values = append(m.values, v)
+		}
+	}
+}
+
+// mask replaces the registered values in the message.
+func (m *secretMask) mask(msg string) string {
+	if m == nil {
+		return msg
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, v := range m.values {
+		msg = strings.ReplaceAll(msg, v, redacted)
+	}
+	return msg
+}
+
+// maskSensitive masks the values of the sensitive attributes in the logs written with the returned context,
+// and in the driver's logs, hence they don't reach the TF_LOG output.
+func (c *Client) maskSensitive(ctx context.Context, values ...string) context.Context {
+	values = slices.DeleteFunc(slices.Clone(values), func(v string) bool { return v == "" })
+	if len(values) == 0 {
+		return ctx
+	}
+	if c != nil {
+		c.secrets.add(values...)
+	}
+	ctx = tflog.MaskMessageStrings(ctx, values...)
+	return tflog.MaskAllFieldValuesStrings(ctx, values...)
+}
+
+// maskPassword masks the provider's password in the logs written with the returned context,
+// both under the db_password field, and in any other field, or message it leaks to.
+func maskPassword(ctx context.Context, password string) context.Context {
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "db_password")
+	if password == "" {
+		return ctx
+	}
+	ctx = tflog.MaskMessageStrings(ctx, password)
+	return tflog.MaskAllFieldValuesStrings(ctx, password)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientMaskSensitive(t *testing.T) {
+	var buf bytes.Buffer
+	c := &Client{secrets: &secretMask{}}
+	ctx := c.maskSensitive(tflogtest.RootLogger(context.Background(), &buf), "s3cr3t", "")
+
+	tflog.Debug(ctx, "connecting with s3cr3t", map[string]interface{}{"password": "s3cr3t"})
+	entries, err := tflogtest.MultilineJSONDecode(&buf)
+	if !assert.NoError(t, err) || !assert.Len(t, entries, 1) {
+		return
+	}
+	assert.NotContains(t, entries[0]["@message"], "s3cr3t")
+	assert.NotEqual(t, "s3cr3t", entries[0]["password"])
+
+	assert.Equal(t, []string{"s3cr3t"}, c.secrets.values, "the empty values shall be skipped")
+	assert.Equal(t, "auth failed for "+redacted, c.secrets.mask("auth failed for s3cr3t"))
+	assert.Equal(t, "s3cr3t", (*secretMask)(nil).mask("s3cr3t"))
+}
+
+func TestMaskPassword(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := maskPassword(tflogtest.RootLogger(context.Background(), &buf), "s3cr3t")
+
+	tflog.Debug(ctx, "connecting with s3cr3t", map[string]interface{}{
+		"db_password": "s3cr3t",
+		"error":       "auth failed for s3cr3t",
+	})
+	entries, err := tflogtest.MultilineJSONDecode(&buf)
+	if !assert.NoError(t, err) || !assert.Len(t, entries, 1) {
+		return
+	}
+	assert.NotContains(t, entries[0]["@message"], "s3cr3t")
+	assert.NotEqual(t, "s3cr3t", entries[0]["db_password"])
+	assert.NotContains(t, entries[0]["error"], "s3cr3t")
+
+	buf.Reset()
+	ctx = maskPassword(tflogtest.RootLogger(context.Background(), &buf), "")
+	tflog.Debug(ctx, "connecting", map[string]interface{}{"db_user": "neo4j"})
+	entries, err = tflogtest.MultilineJSONDecode(&buf)
+	if !assert.NoError(t, err) || !assert.Len(t, entries, 1) {
+		return
+	}
+	assert.Equal(t, "neo4j", entries[0]["db_user"], "the empty password shall not mask the other fields")
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sync"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// refreshBookmark pins the reads of the nodes and the relationships to the bookmark taken
+// when the first of them is read, i.e. every read sees at least the transactions committed
+// before the refresh started, regardless of the cluster member it's routed to.
+// Neo4j has no snapshot reads across the transactions, hence the transactions committed
+// during the refresh may still be visible.
+type refreshBookmark struct {
+	once      sync.Once
+	bookmarks neo4j.Bookmarks
+	err       error
+}
+
+// get takes the bookmark of the latest transaction committed on the leader, it's only taken once.
+func (b *refreshBookmark) get(ctx context.Context, c *Client) (neo4j.Bookmarks, error) {
+	b.once.Do(func() {
+		manager := neo4j.NewBookmarkManager(neo4j.BookmarkManagerConfig{})
+		if _, b.err = neo4j.ExecuteQuery(ctx, c.driver, `RETURN 1`, nil, neo4j.EagerResultTransformer,
+			append(c.executeQueryConfigurers(c.database), neo4j.ExecuteQueryWithWritersRouting(),
+				neo4j.ExecuteQueryWithBookmarkManager(manager))...); b.err != nil {
+			return
+		}
+		b.bookmarks, b.err = manager.GetBookmarks(ctx)
+	})
+	return b.bookmarks, b.err
+}
+
+// runRefresh executes the query to read the entities during the refresh, and returns its records.
+// The query is run at the refresh bookmark in the read transaction if the consistent refresh is enabled.
+func (c *Client) runRefresh(ctx context.Context, query string, params map[string]any) ([]*neo4j.Record, error) {
+	if c.refreshBookmark == nil {
+		dbResp, err := c.Run(ctx, query, params)
+		if err != nil {
+			return nil, err
+		}
+		return dbResp.Collect(ctx)
+	}
+
+	bookmarks, err := c.refreshBookmark.get(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	session := c.driver.NewSession(ctx, neo4j.SessionConfig{
+		DatabaseName:     c.database,
+		AccessMode:       neo4j.AccessModeRead,
+		Bookmarks:        bookmarks,
+		ImpersonatedUser: c.impersonatedUser,
+	})
+	defer func() { _ = session.Close(ctx) }()
+
+	c.queryLog.log(c.database, query, params)
+	records, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		dbResp, err := tx.Run(ctx, query, params)
+		if err != nil {
+			return nil, err
+		}
+		return dbResp.Collect(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return records.([]*neo4j.Record), nil
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientRunRefresh(t *testing.T) {
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:       types.StringValue(testDbURI),
+		DatabaseUser:      types.StringValue(testDBUser),
+		DatabasePassword:  types.StringValue(testDBPass),
+		ConsistentRefresh: types.BoolValue(true),
+	})
+	if err != nil {
+		t.Fatalf("could not connect to database: %v", err)
+	}
+	t.Cleanup(func() {
+		_, _ = c.Run(ctx, "MATCH (n:RefreshBookmark) DELETE n", nil)
+		_ = c.Close(ctx)
+	})
+
+	if _, err := c.Run(ctx, `CREATE (:RefreshBookmark{uuid: "refresh-bookmark"})`, nil); err != nil {
+		t.Fatalf("failed to create the node: %v", err)
+	}
+
+	records, err := c.runRefresh(ctx, `MATCH (n:RefreshBookmark) RETURN n.uuid`, nil)
+	assert.NoError(t, err)
+	if assert.Len(t, records, 1) {
+		assert.Equal(t, "refresh-bookmark", records[0].Values[0])
+	}
+
+	bookmarks, err := c.refreshBookmark.get(ctx, c)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, bookmarks, "the bookmark shall be taken on the first read")
+
+	_, err = c.runRefresh(ctx, `MATCH (n:RefreshBookmark) RETURN n.uuid`, nil)
+	assert.NoError(t, err)
+	again, _ := c.refreshBookmark.get(ctx, c)
+	assert.Equal(t, bookmarks, again, "the bookmark shall only be taken once")
+}
@@ -8,12 +8,17 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -22,6 +27,7 @@ import (
 
 var _ resource.Resource = &RelationshipResource{}
 var _ resource.ResourceWithImportState = &RelationshipResource{}
+var _ resource.ResourceWithModifyPlan = &RelationshipResource{}
 
 func NewRelationshipResource() resource.Resource {
 	return &RelationshipResource{}
@@ -34,15 +40,155 @@ type RelationshipResourceModel struct {
 	EndNodeID   types.String `tfsdk:"end_node_id"`
 	Properties  types.Map    `tfsdk:"properties"`
 	ID          types.String `tfsdk:"id"`
+	Statements  types.List   `tfsdk:"statements"`
+
+	PropertyTypes types.Map  `tfsdk:"property_types"`
+	CoerceTypes   types.Bool `tfsdk:"coerce_types"`
+
+	Weight types.Float64 `tfsdk:"weight"`
+	Order  types.Int64   `tfsdk:"order"`
+
+	StartNodeLabels types.List `tfsdk:"start_node_labels"`
+	EndNodeLabels   types.List `tfsdk:"end_node_labels"`
+
+	Direction types.String `tfsdk:"direction"`
+
+	VerifyAfterWrite types.Bool `tfsdk:"verify_after_write"`
+
+	ExecuteAsRole types.String `tfsdk:"execute_as_role"`
+
+	Preconditions  types.List `tfsdk:"preconditions"`
+	Postconditions types.List `tfsdk:"postconditions"`
 }
 
 // RelationshipResource defines the `Node` resource implementation.
 type RelationshipResource struct {
-	client neo4j.SessionWithContext
+	client *Client
 }
 
 const edgeSuffix = "_relationship"
 
+const (
+	relationshipCreateQuery = `OPTIONAL MATCH (nStart{uuid:$uuidStart}), (nEnd{uuid:$uuidEnd})
+MERGE (nStart)-[r:$($type)]->(nEnd)
+SET r += $properties, r.uuid = $uuid
+`
+	relationshipReadQuery = `MATCH (nStart{uuid:$uuidStart})-[r{uuid:$uuid}]->(nEnd{uuid:$uuidEnd})
+RETURN r, labels(nStart), labels(nEnd)`
+	relationshipReadUndirectedQuery = `MATCH (nStart{uuid:$uuidStart})-[r{uuid:$uuid}]-(nEnd{uuid:$uuidEnd})
+RETURN r, labels(nStart), labels(nEnd)`
+	relationshipUpdateQuery = `OPTIONAL MATCH ({uuid:$uuidStart})-[r:$($type){uuid:$uuid}]->({uuid:$uuidEnd})
+SET r = {}
+SET r += $properties, r.uuid = $uuid
+`
+	relationshipUpdateUndirectedQuery = `OPTIONAL MATCH ({uuid:$uuidStart})-[r:$($type){uuid:$uuid}]-({uuid:$uuidEnd})
+SET r = {}
+SET r += $properties, r.uuid = $uuid
+`
+	relationshipDeleteQuery = `OPTIONAL MATCH ({uuid:$uuidStart})-[r:$($type){uuid:$uuid}]->({uuid:$uuidEnd})
+DELETE r`
+	relationshipDeleteUndirectedQuery = `OPTIONAL MATCH ({uuid:$uuidStart})-[r:$($type){uuid:$uuid}]-({uuid:$uuidEnd})
+DELETE r`
+	relationshipVerifyQuery = `MATCH ()-[r{uuid:$uuid}]->() RETURN properties(r)`
+	relationshipExistsQuery = `RETURN EXISTS { MATCH ()-[{uuid:$uuid}]->() }`
+	// relationshipImportQuery reads the relationship and its start and end nodes following its stored direction.
+	relationshipImportQuery = `MATCH (n)-[r{uuid:$uuid}]->(m)
+RETURN {start_node_id: n.uuid, end_node_id: m.uuid, r: r,
+  start_node_labels: labels(n), end_node_labels: labels(m)} AS resp`
+
+	// directionOutgoing matches the relationship from the start to the end node.
+	directionOutgoing = "OUTGOING"
+	// directionUndirected matches the relationship between the nodes regardless of its direction.
+	directionUndirected = "UNDIRECTED"
+
+	relationshipWeightProperty = "weight"
+	relationshipOrderProperty  = "order"
+)
+
+// ReadProperties reads the properties to write, including the weight and the order set by their attributes.
+func (m RelationshipResourceModel) ReadProperties(ctx context.Context) (map[string]any, diag.Diagnostics) {
+	properties, diags := readProperties(ctx, m.Properties, m.PropertyTypes, m.CoerceTypes)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if m.Weight.IsNull() && m.Order.IsNull() {
+		return properties, diags
+	}
+
+	if properties == nil {
+		properties = make(map[string]any, 2)
+	}
+	for k, v := range map[string]attr.Value{relationshipWeightProperty: m.Weight, relationshipOrderProperty: m.Order} {
+		if v.IsNull() {
+			continue
+		}
+		if _, ok := properties[k]; ok {
+			diags.AddAttributeError(path.Root("properties").AtMapKey(k), "conflicting property",
+				fmt.Sprintf("the property %s is set by the attribute %s", k, k))
+		}
+	}
+	if !m.Weight.IsNull() {
+		properties[relationshipWeightProperty] = m.Weight.ValueFloat64()
+	}
+	if !m.Order.IsNull() {
+		properties[relationshipOrderProperty] = m.Order.ValueInt64()
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+	return properties, diags
+}
+
+// readTypedProperties sets the weight and the order read from the database, and returns the other properties.
+// The weight and the order are read to their attributes if they're set by them,
+// or if they're imported and stored as the float and the integer respectively.
+func (m *RelationshipResourceModel) readTypedProperties(properties map[string]any, imported bool) map[string]string {
+	managedWeight, managedOrder := !m.Weight.IsNull(), !m.Order.IsNull()
+	if managedWeight {
+		m.Weight = types.Float64Null()
+	}
+	if managedOrder {
+		m.Order = types.Int64Null()
+	}
+
+	var o = make(map[string]string, len(properties))
+	for k, v := range properties {
+		switch {
+		// Exclude the system property used to store the resource id.
+		// It's used because the private Neo4j identifier (elementId) may not be reliable
+		// beyond the scope of a single database transaction.
+		case k == "uuid":
+			continue
+		case k == relationshipWeightProperty && (managedWeight || imported):
+			switch vv := v.(type) {
+			case float64:
+				m.Weight = types.Float64Value(vv)
+				continue
+			case int64:
+				if managedWeight {
+					m.Weight = types.Float64Value(float64(vv))
+					continue
+				}
+			}
+		case k == relationshipOrderProperty && (managedOrder || imported):
+			if vv, ok := v.(int64); ok {
+				m.Order = types.Int64Value(vv)
+				continue
+			}
+		}
+		o[k] = formatProperty(v)
+	}
+	return o
+}
+
+// relationshipQueries defines the queries for the direction the relationship is matched in.
+func relationshipQueries(direction types.String) (readQuery, updateQuery, deleteQuery string) {
+	if direction.ValueString() == directionUndirected {
+		return relationshipReadUndirectedQuery, relationshipUpdateUndirectedQuery, relationshipDeleteUndirectedQuery
+	}
+	return relationshipReadQuery, relationshipUpdateQuery, relationshipDeleteQuery
+}
+
 func (e RelationshipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + edgeSuffix
 }
@@ -50,13 +196,18 @@ func (e RelationshipResource) Metadata(_ context.Context, req resource.MetadataR
 func (e RelationshipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Neo4j Relationship, details: " +
-			"https://neo4j.com/docs/getting-started/appendix/graphdb-concepts/#graphdb-relationship",
+			"https://neo4j.com/docs/getting-started/appendix/graphdb-concepts/#graphdb-relationship" +
+			"\n\n-> **Note** The Nodes' IDs unknown when planned, e.g. of the Nodes created by the same apply, " +
+			"are resolved when applied. The change is deferred by Terraform if the Nodes' changes are deferred.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
+				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "Relationship unique identifier.",
+				MarkdownDescription: "Relationship unique identifier. " + externalIDDescription,
+				Validators:          externalIDValidators,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"type": schema.StringAttribute{
@@ -80,6 +231,65 @@ func (e RelationshipResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"weight": schema.Float64Attribute{
+				MarkdownDescription: "The weight of the Relationship stored as the float property `weight`, " +
+					"e.g. the cost of the path used by the graph algorithms. " +
+					"The property cannot be set by `properties` at the same time.",
+				Optional: true,
+			},
+			"order": schema.Int64Attribute{
+				MarkdownDescription: "The order of the Relationship stored as the integer property `order`, " +
+					"e.g. the position of the item in the list. " +
+					"The property cannot be set by `properties` at the same time.",
+				Optional: true,
+			},
+			"property_types": schema.MapAttribute{
+				MarkdownDescription: propertyTypesDescription,
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators:          propertyTypesValidators,
+			},
+			"coerce_types": schema.BoolAttribute{
+				MarkdownDescription: coerceTypesDescription,
+				Optional:            true,
+			},
+			"verify_after_write": schema.BoolAttribute{
+				MarkdownDescription: verifyAfterWriteDescription,
+				Optional:            true,
+			},
+			"execute_as_role": executeAsRoleAttribute,
+			"preconditions":   preconditionsAttribute,
+			"postconditions":  postconditionsAttribute,
+			"statements": schema.ListAttribute{
+				MarkdownDescription: statementsDescription,
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"start_node_labels": schema.ListAttribute{
+				MarkdownDescription: "The labels of the Node where the Relationship starts from.",
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"end_node_labels": schema.ListAttribute{
+				MarkdownDescription: "The labels of the Node where the Relationship ends at.",
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"direction": schema.StringAttribute{
+				MarkdownDescription: "The direction the Relationship is matched in on update and delete: " +
+					"`OUTGOING` from the start to the end Node, or `UNDIRECTED` between the Nodes. " +
+					"The Relationship is always created from the start to the end Node. Defaults to `OUTGOING`." +
+					"\n\n!>**Warning** `UNDIRECTED` may change the wrong Relationship if reciprocal Relationships " +
+					"of the same type exist. It's only kept for the state imported with the swapped Nodes.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(directionOutgoing),
+				Validators: []validator.String{
+					stringvalidator.OneOf(directionOutgoing, directionUndirected),
+				},
+			},
 		},
 	}
 }
@@ -89,17 +299,107 @@ func (e *RelationshipResource) Configure(_ context.Context, req resource.Configu
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(neo4j.SessionWithContext)
+	client, ok := req.ProviderData.(*Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected neo4j.DriverWithContext, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 	e.client = client
 }
 
+func (e RelationshipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse) {
+	var direction types.String
+	if !req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("direction"), &direction)...)
+
+		// The nodes' IDs are unknown if the nodes are created by the same apply, they are resolved when applied.
+		// The change is not deferred, because Terraform defers it itself if the nodes' changes are deferred.
+		var startNodeID, endNodeID types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("start_node_id"), &startNodeID)...)
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("end_node_id"), &endNodeID)...)
+
+		if !req.State.Raw.IsNull() {
+			var state RelationshipResourceModel
+			resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+			resp.Diagnostics.Append(replacementWarnings(state, startNodeID, endNodeID)...)
+		}
+	}
+	_, updateQuery, _ := relationshipQueries(direction)
+	planStatements(ctx, req, resp, relationshipCreateQuery, updateQuery)
+}
+
+// replacementWarnings explains why the relationship is planned to be replaced.
+func replacementWarnings(state RelationshipResourceModel, startNodeID, endNodeID types.String) (diags diag.Diagnostics) {
+	if startNodeID.IsUnknown() || endNodeID.IsUnknown() {
+		if !startNodeID.Equal(state.StartNodeID) || !endNodeID.Equal(state.EndNodeID) {
+			diags.AddWarning("relationship replacement",
+				fmt.Sprintf("The relationship %s will be replaced if the nodes' IDs change when applied.",
+					state.ID.ValueString()))
+		}
+		return diags
+	}
+	switch {
+	case startNodeID.Equal(state.StartNodeID) && endNodeID.Equal(state.EndNodeID):
+	case startNodeID.Equal(state.EndNodeID) && endNodeID.Equal(state.StartNodeID):
+		diags.AddAttributeWarning(path.Root("start_node_id"), "relationship replacement",
+			fmt.Sprintf("The relationship %s will be replaced because its direction changes: "+
+				"it will start from the node %s and end at the node %s.",
+				state.ID.ValueString(), startNodeID.ValueString(), endNodeID.ValueString()))
+	default:
+		if !startNodeID.Equal(state.StartNodeID) {
+			diags.AddAttributeWarning(path.Root("start_node_id"), "relationship replacement",
+				fmt.Sprintf("The relationship %s will be replaced because its start node changes from %s to %s.",
+					state.ID.ValueString(), state.StartNodeID.ValueString(), startNodeID.ValueString()))
+		}
+		if !endNodeID.Equal(state.EndNodeID) {
+			diags.AddAttributeWarning(path.Root("end_node_id"), "relationship replacement",
+				fmt.Sprintf("The relationship %s will be replaced because its end node changes from %s to %s.",
+					state.ID.ValueString(), state.EndNodeID.ValueString(), endNodeID.ValueString()))
+		}
+	}
+	return diags
+}
+
+// toLabels converts the labels returned by the database to the list.
+func toLabels(ctx context.Context, v any) (types.List, diag.Diagnostics) {
+	var labels = make([]string, 0)
+	if l, ok := v.([]any); ok {
+		for _, el := range l {
+			labels = append(labels, fmt.Sprintf("%v", el))
+		}
+	}
+	return types.ListValueFrom(ctx, types.StringType, labels)
+}
+
+// readEndpointLabels reads the labels of the start and the end nodes.
+func (e RelationshipResource) readEndpointLabels(ctx context.Context,
+	data *RelationshipResourceModel) (diags diag.Diagnostics) {
+	dbResp, err := e.client.Run(ctx, `MATCH (nStart{uuid:$uuidStart}), (nEnd{uuid:$uuidEnd})
+RETURN labels(nStart), labels(nEnd)`, map[string]any{
+		"uuidStart": data.StartNodeID.ValueString(),
+		"uuidEnd":   data.EndNodeID.ValueString(),
+	})
+	if err != nil {
+		diags.AddError("failed to read the nodes' labels", err.Error())
+		return diags
+	}
+	rec, err := dbResp.Single(ctx)
+	if err != nil {
+		diags.AddError("failed to read the nodes' labels", err.Error())
+		return diags
+	}
+	var d diag.Diagnostics
+	data.StartNodeLabels, d = toLabels(ctx, rec.Values[0])
+	diags.Append(d...)
+	data.EndNodeLabels, d = toLabels(ctx, rec.Values[1])
+	diags.Append(d...)
+	return diags
+}
+
 func (e RelationshipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data RelationshipResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -107,31 +407,75 @@ func (e RelationshipResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	resp.Diagnostics.Append(e.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer e.client.audit(ctx, &resp.Diagnostics, Name+edgeSuffix, auditOperationCreate, &data.ID)
+	defer e.client.notify(ctx, &resp.Diagnostics, Name+edgeSuffix, auditOperationCreate, &data.ID)
+
+	client, diags := e.client.asRole(ctx, data.ExecuteAsRole)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "create a relationship")
-	id := uuid.NewString()
+	id := configuredID(data.ID)
+	if id != "" {
+		resp.Diagnostics.Append(checkIDAvailable(ctx, e.client, "relationship", id, relationshipExistsQuery)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		var err error
+		if id, err = e.client.newID(); err != nil {
+			resp.Diagnostics.AddError("failed to generate the relationship identifier", err.Error())
+			return
+		}
+	}
 
-	properties, diags := readProperties(ctx, data.Properties)
+	properties, diags := data.ReadProperties(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		tflog.Debug(ctx, "faulty properties provided")
 		return
 	}
-	if _, err := e.client.Run(ctx, `OPTIONAL MATCH (nStart{uuid:$uuidStart}), (nEnd{uuid:$uuidEnd})
-MERGE (nStart)-[r:$($type)]->(nEnd)
-SET r += $properties, r.uuid = $uuid
-`, map[string]any{
+	resp.Diagnostics.Append(e.client.checkOwnsNewRelationship(ctx, data.StartNodeID.ValueString(),
+		data.EndNodeID.ValueString(), properties)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(e.client.checkPreconditions(ctx, data.Preconditions, id, properties)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := client.runWrite(ctx, relationshipCreateQuery, map[string]any{
 		"uuid":       id,
 		"uuidStart":  data.StartNodeID.ValueString(),
 		"uuidEnd":    data.EndNodeID.ValueString(),
 		"type":       data.Type.ValueString(),
 		"properties": properties,
-	}); err != nil {
-		tflog.Debug(ctx, "failed to create the relationship")
-		resp.Diagnostics.AddError("failed to create the relationship", err.Error())
+	}, data.Postconditions, id, properties); err != nil {
+		e.client.addOperationError(ctx, &resp.Diagnostics, diagnosticOperationCreate, "relationship", id, err)
 		return
 	}
+	if data.VerifyAfterWrite.ValueBool() {
+		resp.Diagnostics.Append(verifyWrite(ctx, client, "relationship", id, relationshipVerifyQuery,
+			properties, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	data.ID = types.StringValue(id)
+	resp.Diagnostics.Append(e.readEndpointLabels(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	tflog.Trace(ctx, "created a relationship")
 }
@@ -149,7 +493,8 @@ func (e RelationshipResource) Read(ctx context.Context, req resource.ReadRequest
 	if data.Properties.IsNull() || data.Properties.IsUnknown() {
 		data.Properties = types.MapNull(types.StringType)
 	}
-	dbResp, err := e.client.Run(ctx, `MATCH ({uuid:$uuidStart})-[r{uuid:$uuid}]->({uuid:$uuidEnd}) RETURN r`,
+	readQuery, _, _ := relationshipQueries(data.Direction)
+	records, err := e.client.runRefresh(ctx, readQuery,
 		map[string]any{
 			"uuid":      id,
 			"uuidStart": data.StartNodeID.ValueString(),
@@ -158,31 +503,26 @@ func (e RelationshipResource) Read(ctx context.Context, req resource.ReadRequest
 		})
 	switch err != nil {
 	case true:
-		resp.Diagnostics.AddError("failed to read the relationship", err.Error())
+		e.client.addOperationError(ctx, &resp.Diagnostics, diagnosticOperationRead, "relationship", id, err)
 	default:
-		var rec *neo4j.Record
-		if dbResp.NextRecord(ctx, &rec) {
+		if len(records) > 0 {
+			rec := records[0]
 			relationship := rec.Values[0].(neo4j.Relationship)
 
 			var d diag.Diagnostics
-			if len(relationship.GetProperties()) > 1 {
-				var tmp = make(map[string]string, len(relationship.GetProperties())-1)
-				for k, v := range relationship.GetProperties() {
-					// Exclude the system property used to store the resource id.
-					// It's used because the private Neo4j identifier (elementId) may not be reliable
-					// beyond the scope of a single database transaction.
-					if k != "uuid" {
-						tmp[k] = fmt.Sprintf("%v", v)
-					}
-				}
-				if !(data.Properties.IsNull() && len(tmp) == 0) {
-					data.Properties, d = types.MapValueFrom(ctx, types.StringType, tmp)
-					resp.Diagnostics.Append(d...)
-				}
+			tmp := data.readTypedProperties(relationship.GetProperties(), false)
+			if len(relationship.GetProperties()) > 1 && !(data.Properties.IsNull() && len(tmp) == 0) {
+				data.Properties, d = types.MapValueFrom(ctx, types.StringType, tmp)
+				resp.Diagnostics.Append(d...)
 			}
 
 			data.Type = types.StringValue(relationship.Type)
 
+			data.StartNodeLabels, d = toLabels(ctx, rec.Values[1])
+			resp.Diagnostics.Append(d...)
+			data.EndNodeLabels, d = toLabels(ctx, rec.Values[2])
+			resp.Diagnostics.Append(d...)
+
 		} else {
 			resp.Diagnostics.AddError("no relationship found", id)
 		}
@@ -201,28 +541,75 @@ func (e RelationshipResource) Update(ctx context.Context, req resource.UpdateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(e.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer e.client.audit(ctx, &resp.Diagnostics, Name+edgeSuffix, auditOperationUpdate, &data.ID)
+	defer e.client.notify(ctx, &resp.Diagnostics, Name+edgeSuffix, auditOperationUpdate, &data.ID)
+
+	client, diags := e.client.asRole(ctx, data.ExecuteAsRole)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := data.ID.ValueString()
 	tflog.Trace(ctx, "updating the relationship", map[string]interface{}{"id": id})
 
-	properties, diags := readProperties(ctx, data.Properties)
+	properties, diags := data.ReadProperties(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		tflog.Debug(ctx, "faulty properties provided")
 		return
 	}
 
-	if _, err := e.client.Run(ctx, `OPTIONAL MATCH ({uuid:$uuidStart})-[r:$($type){uuid:$uuid}]-({uuid:$uuidEnd})
-SET r = {}
-SET r += $properties, r.uuid = $uuid
-`, map[string]any{
+	resp.Diagnostics.Append(e.client.checkOwned(ctx, entityTypeRelationship, id)...)
+	resp.Diagnostics.Append(e.client.checkOwnsNewRelationship(ctx, data.StartNodeID.ValueString(),
+		data.EndNodeID.ValueString(), properties)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(e.client.checkPreconditions(ctx, data.Preconditions, id, properties)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stored, err := isStored(ctx, e.client, relationshipVerifyQuery, id, nil, properties, false)
+	if err != nil {
+		e.client.addOperationError(ctx, &resp.Diagnostics, diagnosticOperationUpdate, "relationship", id, err)
+		return
+	}
+
+	_, updateQuery, _ := relationshipQueries(data.Direction)
+	summary, err := client.runWrite(ctx, updateQuery, map[string]any{
 		"uuid":       id,
 		"uuidStart":  data.StartNodeID.ValueString(),
 		"uuidEnd":    data.EndNodeID.ValueString(),
 		"type":       data.Type.ValueString(),
 		"properties": properties,
-	}); err != nil {
-		tflog.Debug(ctx, "failed to update the relationship")
-		resp.Diagnostics.AddError("failed to update the relationship", err.Error())
+	}, data.Postconditions, id, properties)
+	if err != nil {
+		e.client.addOperationError(ctx, &resp.Diagnostics, diagnosticOperationUpdate, "relationship", id, err)
+		return
+	}
+	resp.Diagnostics.Append(checkUpdated(ctx, e.client, summary, stored, "relationship", id, relationshipExistsQuery)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.VerifyAfterWrite.ValueBool() {
+		resp.Diagnostics.Append(verifyWrite(ctx, client, "relationship", id, relationshipVerifyQuery,
+			properties, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(e.readEndpointLabels(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -240,9 +627,31 @@ func (e RelationshipResource) Delete(ctx context.Context, req resource.DeleteReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(e.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The identifier is reset once the relationship is deleted.
+	id := data.ID
+	defer e.client.audit(ctx, &resp.Diagnostics, Name+edgeSuffix, auditOperationDelete, &id)
+	defer e.client.notify(ctx, &resp.Diagnostics, Name+edgeSuffix, auditOperationDelete, &id)
+
+	client, diags := e.client.asRole(ctx, data.ExecuteAsRole)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "delete the relationship")
-	if _, err := e.client.Run(ctx,
-		`OPTIONAL MATCH ({uuid:$uuidStart})-[r:$($type){uuid:$uuid}]-({uuid:$uuidEnd}) DELETE r`,
+	resp.Diagnostics.Append(e.client.checkOwned(ctx, entityTypeRelationship, data.ID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, deleteQuery := relationshipQueries(data.Direction)
+	if _, err := client.Run(ctx, deleteQuery,
 		map[string]any{
 			"uuid":      data.ID.ValueString(),
 			"uuidStart": data.StartNodeID.ValueString(),
@@ -250,8 +659,8 @@ func (e RelationshipResource) Delete(ctx context.Context, req resource.DeleteReq
 			"type":      data.Type.ValueString(),
 		},
 	); err != nil {
-		tflog.Debug(ctx, "failed to delete the relationship")
-		resp.Diagnostics.AddError("failed to delete the relationship", err.Error())
+		e.client.addOperationError(ctx, &resp.Diagnostics, diagnosticOperationDelete, "relationship",
+			data.ID.ValueString(), err)
 		return
 	}
 	data.ID = types.StringNull()
@@ -265,20 +674,26 @@ func (e RelationshipResource) Delete(ctx context.Context, req resource.DeleteReq
 
 func (e RelationshipResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse) {
+	id, err := e.client.importID(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("unexpected import identifier", err.Error())
+		return
+	}
+
 	var data RelationshipResourceModel
-	data.ID = basetypes.NewStringValue(req.ID)
+	data.ID = basetypes.NewStringValue(id)
+	data.Statements = types.ListNull(types.StringType)
+	data.Direction = types.StringValue(directionOutgoing)
 	tflog.Trace(ctx, "importing the relationship", map[string]interface{}{"id": req.ID})
 
 	if data.Properties.IsNull() || data.Properties.IsUnknown() {
 		data.Properties = types.MapNull(types.StringType)
 	}
 
-	id := data.ID.ValueString()
-	dbResp, err := e.client.Run(ctx, `MATCH (n)-[r{uuid:$uuid}]->(m) 
-RETURN {start_node_id:n.uuid, end_node_id:n.uuid, r: r} AS resp`, map[string]any{"uuid": id})
+	dbResp, err := e.client.Run(ctx, relationshipImportQuery, map[string]any{"uuid": id})
 	switch err != nil {
 	case true:
-		resp.Diagnostics.AddError("failed to read the relationship", err.Error())
+		e.client.addOperationError(ctx, &resp.Diagnostics, diagnosticOperationImport, "relationship", id, err)
 	default:
 		var rec *neo4j.Record
 		if dbResp.NextRecord(ctx, &rec) {
@@ -286,25 +701,26 @@ RETURN {start_node_id:n.uuid, end_node_id:n.uuid, r: r} AS resp`, map[string]any
 			relationship := m["r"].(neo4j.Relationship)
 
 			var d diag.Diagnostics
-			if len(relationship.GetProperties()) > 1 {
-				var tmp = make(map[string]string, len(relationship.GetProperties())-1)
-				for k, v := range relationship.GetProperties() {
-					// Exclude the system property used to store the resource id.
-					// It's used because the private Neo4j identifier (elementId) may not be reliable
-					// beyond the scope of a single database transaction.
-					if k != "uuid" {
-						tmp[k] = fmt.Sprintf("%v", v)
-					}
-				}
-				if !(data.Properties.IsNull() && len(tmp) == 0) {
-					data.Properties, d = types.MapValueFrom(ctx, types.StringType, tmp)
-					resp.Diagnostics.Append(d...)
-				}
+			tmp := data.readTypedProperties(relationship.GetProperties(), true)
+			if len(relationship.GetProperties()) > 1 && !(data.Properties.IsNull() && len(tmp) == 0) {
+				data.Properties, d = types.MapValueFrom(ctx, types.StringType, tmp)
+				resp.Diagnostics.Append(d...)
 			}
 
 			data.Type = types.StringValue(relationship.Type)
-			data.StartNodeID = types.StringValue(m["start_node_id"].(string))
-			data.EndNodeID = types.StringValue(m["end_node_id"].(string))
+			startNodeID, okStart := m["start_node_id"].(string)
+			endNodeID, okEnd := m["end_node_id"].(string)
+			if !okStart || !okEnd {
+				resp.Diagnostics.AddError("unmanaged relationship's nodes",
+					fmt.Sprintf("the start and the end nodes of the relationship %s shall have the uuid property", id))
+			}
+			data.StartNodeID = types.StringValue(startNodeID)
+			data.EndNodeID = types.StringValue(endNodeID)
+
+			data.StartNodeLabels, d = toLabels(ctx, m["start_node_labels"])
+			resp.Diagnostics.Append(d...)
+			data.EndNodeLabels, d = toLabels(ctx, m["end_node_labels"])
+			resp.Diagnostics.Append(d...)
 
 		} else {
 			resp.Diagnostics.AddError("no relationship found", id)
@@ -315,7 +731,7 @@ RETURN {start_node_id:n.uuid, end_node_id:n.uuid, r: r} AS resp`, map[string]any
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-	if !resp.Diagnostics.HasError() {
+	if resp.Diagnostics.HasError() {
 		tflog.Trace(ctx, "failed to import to state")
 		return
 	}
@@ -13,15 +13,143 @@ import (
 	"testing"
 
 	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 	"github.com/stretchr/testify/assert"
 )
 
+func TestRelationshipModifyPlanUnknownNodes(t *testing.T) {
+	ctx := context.Background()
+	var schemaResp fwresource.SchemaResponse
+	RelationshipResource{}.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	// newPlan defines the plan to create the relationship from the node created by the same apply.
+	newPlan := func() tfsdk.Plan {
+		objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		var values = make(map[string]tftypes.Value, len(objType.AttributeTypes))
+		for k, v := range objType.AttributeTypes {
+			values[k] = tftypes.NewValue(v, nil)
+		}
+		values["type"] = tftypes.NewValue(tftypes.String, "LINK")
+		values["start_node_id"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+		values["end_node_id"] = tftypes.NewValue(tftypes.String, "bar")
+		return tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objType, values)}
+	}
+
+	for _, deferralAllowed := range []bool{true, false} {
+		t.Run(fmt.Sprintf("deferral allowed: %v", deferralAllowed), func(t *testing.T) {
+			req := fwresource.ModifyPlanRequest{
+				Plan: newPlan(),
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+				ClientCapabilities: fwresource.ModifyPlanClientCapabilities{DeferralAllowed: deferralAllowed},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+			RelationshipResource{}.ModifyPlan(ctx, req, resp)
+
+			// the relationship is applied together with its nodes
+			assert.False(t, resp.Diagnostics.HasError())
+			assert.Nil(t, resp.Deferred)
+			var statements types.List
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("statements"), &statements)...)
+			assert.False(t, resp.Diagnostics.HasError())
+			assert.False(t, statements.IsUnknown())
+		})
+	}
+}
+
+func TestReplacementWarnings(t *testing.T) {
+	state := RelationshipResourceModel{
+		ID:          types.StringValue("rel"),
+		StartNodeID: types.StringValue("a"),
+		EndNodeID:   types.StringValue("b"),
+	}
+	tests := []struct {
+		name                   string
+		startNodeID, endNodeID types.String
+		want                   []string
+	}{
+		{
+			name:        "unchanged",
+			startNodeID: types.StringValue("a"),
+			endNodeID:   types.StringValue("b"),
+		},
+		{
+			name:        "direction",
+			startNodeID: types.StringValue("b"),
+			endNodeID:   types.StringValue("a"),
+			want: []string{"The relationship rel will be replaced because its direction changes: " +
+				"it will start from the node b and end at the node a."},
+		},
+		{
+			name:        "endpoints",
+			startNodeID: types.StringValue("c"),
+			endNodeID:   types.StringValue("d"),
+			want: []string{
+				"The relationship rel will be replaced because its start node changes from a to c.",
+				"The relationship rel will be replaced because its end node changes from b to d.",
+			},
+		},
+		{
+			name:        "unknown",
+			startNodeID: types.StringUnknown(),
+			endNodeID:   types.StringValue("b"),
+			want:        []string{"The relationship rel will be replaced if the nodes' IDs change when applied."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, d := range replacementWarnings(state, tt.startNodeID, tt.endNodeID) {
+				got = append(got, d.Detail())
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRelationshipTypedProperties(t *testing.T) {
+	ctx := context.Background()
+
+	data := RelationshipResourceModel{
+		Properties:    types.MapValueMust(types.StringType, map[string]attr.Value{"since": types.StringValue("2020")}),
+		PropertyTypes: types.MapNull(types.StringType),
+		Weight:        types.Float64Value(0.5),
+		Order:         types.Int64Value(2),
+	}
+	got, diags := data.ReadProperties(ctx)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, map[string]any{"since": int64(2020), "weight": 0.5, "order": int64(2)}, got)
+
+	data.Properties = types.MapValueMust(types.StringType, map[string]attr.Value{"weight": types.StringValue("1")})
+	_, diags = data.ReadProperties(ctx)
+	assert.True(t, diags.HasError(), "the weight shall not be set by the properties and the attribute at once")
+
+	data = RelationshipResourceModel{Weight: types.Float64Value(0.5)}
+	assert.Equal(t, map[string]string{"order": "3"},
+		data.readTypedProperties(map[string]any{"uuid": "id", "weight": 1.5, "order": int64(3)}, false))
+	assert.Equal(t, types.Float64Value(1.5), data.Weight)
+	assert.True(t, data.Order.IsNull(), "the order shall only be read to the attribute if it's set by it")
+
+	data = RelationshipResourceModel{}
+	assert.Equal(t, map[string]string{"weight": "heavy"},
+		data.readTypedProperties(map[string]any{"weight": "heavy", "order": int64(3)}, true))
+	assert.True(t, data.Weight.IsNull())
+	assert.Equal(t, types.Int64Value(3), data.Order)
+}
+
 func TestAccRelationshipResource(t *testing.T) {
 	t.Setenv("DB_URI", testDbURI)
 	t.Setenv("DB_USER", testDBUser)
@@ -80,9 +208,10 @@ func TestAccRelationshipResource(t *testing.T) {
 				},
 				// ImportState testing
 				{
-					ResourceName:      configInit.resourceAddress(),
-					ImportState:       true,
-					ImportStateVerify: true,
+					ResourceName:            configInit.resourceAddress(),
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"statements"},
 				},
 				// Update and Read testing
 				{
@@ -91,9 +220,10 @@ func TestAccRelationshipResource(t *testing.T) {
 				},
 				// ImportState testing
 				{
-					ResourceName:      configPlain.resourceAddress(),
-					ImportState:       true,
-					ImportStateVerify: true,
+					ResourceName:            configPlain.resourceAddress(),
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"statements"},
 				},
 			},
 		})
@@ -106,6 +236,134 @@ CALL apoc.util.validate(NOT r IS NULL, "relationship shall be deleted", [])`,
 		assert.NoError(t, err)
 	})
 
+	t.Run("endpoint labels", func(t *testing.T) {
+		const resourceAddress = resourceRelationshipName + ".labels"
+		const config = `resource "neo4j_node" "start" {
+labels = ["Person"]
+}
+
+resource "neo4j_node" "end" {
+labels = ["City"]
+}
+
+resource "neo4j_relationship" "labels" {
+type          = "LIVES_IN"
+start_node_id = neo4j_node.start.id
+end_node_id   = neo4j_node.end.id
+}`
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(resourceAddress, tfjsonpath.New("start_node_labels"),
+							knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("Person")})),
+						statecheck.ExpectKnownValue(resourceAddress, tfjsonpath.New("end_node_labels"),
+							knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("City")})),
+					},
+				},
+				{
+					ResourceName:            resourceAddress,
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"statements"},
+				},
+			},
+		})
+	})
+
+	t.Run("reciprocal relationships", func(t *testing.T) {
+		const config = `resource "neo4j_node" "a" {}
+
+resource "neo4j_node" "b" {}
+
+resource "neo4j_relationship" "ab" {
+type          = "RECIPROCAL"
+start_node_id = neo4j_node.a.id
+end_node_id   = neo4j_node.b.id
+properties    = { weight = "%s" }
+}
+
+resource "neo4j_relationship" "ba" {
+type          = "RECIPROCAL"
+start_node_id = neo4j_node.b.id
+end_node_id   = neo4j_node.a.id
+properties    = { weight = "2" }
+}`
+		// checkWeights validates the relationships in the database.
+		checkWeights := func(s *terraform.State) error {
+			dbResp, err := c.Run(ctx, `MATCH (a{uuid:$a})-[ab:RECIPROCAL]->(b{uuid:$b}), (b)-[ba:RECIPROCAL]->(a)
+CALL apoc.util.validate(ab.weight <> 3 OR ba.weight <> 2, "unexpected weights: %s, %s", [ab.weight, ba.weight])
+RETURN count(*)`, map[string]any{
+				"a": s.RootModule().Resources[resourceNodeName+".a"].Primary.ID,
+				"b": s.RootModule().Resources[resourceNodeName+".b"].Primary.ID,
+			})
+			if err != nil {
+				return err
+			}
+			_, err = dbResp.Single(ctx)
+			return err
+		}
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(config, "1"),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(resourceRelationshipName+".ab", tfjsonpath.New("direction"),
+							knownvalue.StringExact(directionOutgoing)),
+					},
+				},
+				{
+					Config: fmt.Sprintf(config, "3"),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(resourceRelationshipName+".ab", tfjsonpath.New("statements"),
+							knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact(relationshipUpdateQuery)})),
+					},
+					Check: checkWeights,
+				},
+				// The endpoints of both relationships between the same nodes shall round-trip.
+				{
+					ResourceName:            resourceRelationshipName + ".ab",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"statements"},
+				},
+				{
+					ResourceName:            resourceRelationshipName + ".ba",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"statements"},
+				},
+			},
+		})
+	})
+
+	t.Run("unicode and special characters type", func(t *testing.T) {
+		cfg := configRelationship{
+			client:            c,
+			resourceTfVarName: "_",
+			WantType:          "is part-of ✓",
+			WantProperties:    map[string]any{"comment": "größer als"},
+		}
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:            cfg.generateConfig(),
+					ConfigStateChecks: []statecheck.StateCheck{cfg},
+				},
+				{
+					ResourceName:            cfg.resourceAddress(),
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"statements"},
+				},
+			},
+		})
+	})
+
 	t.Run("properties = {} vs properties = null", func(t *testing.T) {
 		cfg := configRelationship{
 			client:            c,
@@ -127,9 +385,10 @@ CALL apoc.util.validate(NOT r IS NULL, "relationship shall be deleted", [])`,
 					ConfigStateChecks: []statecheck.StateCheck{cfgNull},
 				},
 				{
-					ResourceName:      cfgNull.resourceAddress(),
-					ImportState:       true,
-					ImportStateVerify: true,
+					ResourceName:            cfgNull.resourceAddress(),
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"statements"},
 				},
 				{
 					Config:            cfg.generateConfig(),
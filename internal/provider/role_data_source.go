@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RoleDataSource{}
+
+func NewRoleDataSource() datasource.DataSource {
+	return &RoleDataSource{}
+}
+
+// RoleDataSource defines the data source to look up the role.
+type RoleDataSource struct {
+	client *Client
+}
+
+// RoleDataSourceModel describes the data source data model.
+type RoleDataSourceModel struct {
+	Name       types.String `tfsdk:"name"`
+	Members    types.List   `tfsdk:"members"`
+	Privileges types.List   `tfsdk:"privileges"`
+}
+
+const roleSuffix = "_role"
+
+const (
+	roleMembersQuery = `SHOW ROLES WITH USERS
+YIELD role, member
+WHERE role = $name
+RETURN collect(member)`
+	rolePrivilegesQuery = `SHOW ROLE $name PRIVILEGES AS COMMANDS
+YIELD command
+RETURN command
+ORDER BY command`
+)
+
+func (d *RoleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + roleSuffix
+}
+
+func (d *RoleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Neo4j role, details: " +
+			"https://neo4j.com/docs/operations-manual/current/authentication-authorization/manage-roles/" +
+			"\n\n!>**Warning** The data source requires the Neo4j Enterprise Edition.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The role name.",
+				Required:            true,
+			},
+			"members": schema.ListAttribute{
+				MarkdownDescription: "The users the role is granted to.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"privileges": schema.ListAttribute{
+				MarkdownDescription: "The privileges of the role as the Cypher commands, " +
+					"e.g. `GRANT MATCH {*} ON GRAPH * NODE * TO $role`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *RoleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RoleDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "reading the role")
+
+	params := map[string]any{"name": data.Name.ValueString()}
+	dbResp, err := d.client.RunSystem(ctx, `SHOW ROLES YIELD role WHERE role = $name RETURN role`, params)
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the role")
+		resp.Diagnostics.AddError("failed to read the role", err.Error())
+		return
+	}
+	if len(dbResp.Records) == 0 {
+		resp.Diagnostics.AddError("role not found", fmt.Sprintf("the role %s does not exist", data.Name.ValueString()))
+		return
+	}
+
+	dbResp, err = d.client.RunSystem(ctx, roleMembersQuery, params)
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the role's members")
+		resp.Diagnostics.AddError("failed to read the role's members", err.Error())
+		return
+	}
+	var diags diag.Diagnostics
+	data.Members, diags = toLabels(ctx, dbResp.Records[0].Values[0])
+	resp.Diagnostics.Append(diags...)
+
+	dbResp, err = d.client.RunSystem(ctx, rolePrivilegesQuery, params)
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the role's privileges")
+		resp.Diagnostics.AddError("failed to read the role's privileges", err.Error())
+		return
+	}
+	var privileges = make([]string, len(dbResp.Records))
+	for i, rec := range dbResp.Records {
+		privileges[i] = fmt.Sprintf("%v", rec.Values[0])
+	}
+	data.Privileges, diags = types.ListValueFrom(ctx, types.StringType, privileges)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the role", map[string]interface{}{"privileges": len(privileges)})
+}
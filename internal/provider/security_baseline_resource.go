@@ -0,0 +1,431 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SecurityBaselineResource{}
+
+func NewSecurityBaselineResource() resource.Resource {
+	return &SecurityBaselineResource{}
+}
+
+// SecurityBaselineResource defines the resource to set the default-deny security baseline.
+type SecurityBaselineResource struct {
+	client *Client
+}
+
+// SecurityBaselineResourceModel describes the resource data model.
+type SecurityBaselineResourceModel struct {
+	RevokePublic types.Bool `tfsdk:"revoke_public"`
+	Privileges   types.List `tfsdk:"privileges"`
+}
+
+const securityBaselineSuffix = "_security_baseline"
+
+// publicPrivileges defines the privileges granted to the PUBLIC role by default.
+var publicPrivileges = []string{
+	"GRANT ACCESS ON HOME DATABASE TO PUBLIC",
+	"GRANT EXECUTE PROCEDURE * ON DBMS TO PUBLIC",
+	"GRANT EXECUTE FUNCTION * ON DBMS TO PUBLIC",
+}
+
+var grantCommandRegexp = regexp.MustCompile(`(?i)^GRANT\s+.+\s+TO\s+\S+$`)
+
+// privateKeyPrivileges is the private state key of the privileges shown for every configured command.
+const privateKeyPrivileges = "privileges"
+
+// normalizePrivilegeCommand brings the privilege command to compare it regardless of the quoting and the whitespaces.
+// The letter case is kept, because the names of the roles and the graphs are case-sensitive.
+func normalizePrivilegeCommand(command string) string {
+	return strings.Join(strings.Fields(strings.ReplaceAll(command, "`", "")), " ")
+}
+
+// splitRole splits the GRANT command into the granted privilege and the role it's granted to.
+func splitRole(command string) (privilege, role string) {
+	command = strings.Join(strings.Fields(command), " ")
+	i := strings.LastIndex(strings.ToUpper(command), " TO ")
+	return command[:i], command[i+len(" TO "):]
+}
+
+// revokeCommand defines the command to revoke the granted privilege.
+func revokeCommand(command string) string {
+	privilege, role := splitRole(command)
+	return "REVOKE " + privilege + " FROM " + role
+}
+
+func (r *SecurityBaselineResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + securityBaselineSuffix
+}
+
+func (r *SecurityBaselineResource) Schema(_ context.Context, _ resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Default-deny security baseline: revokes the privileges granted to the `PUBLIC` role " +
+			"by default, and grants the minimal set of privileges. " +
+			"The privileges revoked, or the default privileges granted outside of Terraform are reported as drift." +
+			"\n\n!>**Warning** The resource requires the Neo4j Enterprise Edition. " +
+			"Define a single baseline per DBMS, the default privileges of the `PUBLIC` role are restored on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"revoke_public": schema.BoolAttribute{
+				MarkdownDescription: "Whether to revoke the default privileges of the `PUBLIC` role: " +
+					"the access to the home database, and the execution of the procedures and the functions. " +
+					"Defaults to `true`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"privileges": schema.ListAttribute{
+				MarkdownDescription: "The `GRANT` commands defining the minimal set of privileges, " +
+					"e.g. `GRANT ACCESS ON DATABASE movies TO reader`. The command is compared to the privileges " +
+					"shown by `SHOW PRIVILEGES AS COMMANDS` when it was applied, e.g. `MATCH` to `TRAVERSE` and `READ`.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(grantCommandRegexp,
+						"must be the GRANT command")),
+				},
+			},
+		},
+	}
+}
+
+func (r *SecurityBaselineResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// runCommands runs the privilege commands.
+func (r *SecurityBaselineResource) runCommands(ctx context.Context, commands []string) error {
+	for _, command := range commands {
+		if _, err := r.client.RunSystem(ctx, command, nil); err != nil {
+			return fmt.Errorf("%s: %w", command, err)
+		}
+	}
+	return nil
+}
+
+// apply brings the privileges to the planned baseline.
+func (r *SecurityBaselineResource) apply(ctx context.Context, plan SecurityBaselineResourceModel,
+	state *SecurityBaselineResourceModel) (diags diag.Diagnostics) {
+	var planned, current []string
+	if !plan.Privileges.IsNull() {
+		diags.Append(plan.Privileges.ElementsAs(ctx, &planned, false)...)
+	}
+	if state != nil && !state.Privileges.IsNull() {
+		diags.Append(state.Privileges.ElementsAs(ctx, &current, false)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	var commands []string
+	switch {
+	case plan.RevokePublic.ValueBool() && (state == nil || !state.RevokePublic.ValueBool()):
+		for _, command := range publicPrivileges {
+			commands = append(commands, revokeCommand(command))
+		}
+	case !plan.RevokePublic.ValueBool() && state != nil && state.RevokePublic.ValueBool():
+		commands = append(commands, publicPrivileges...)
+	}
+	for _, command := range current {
+		if !containsCommand(planned, command) {
+			commands = append(commands, revokeCommand(command))
+		}
+	}
+	// The commands are idempotent, hence the privileges revoked outside of Terraform are granted again.
+	commands = append(commands, planned...)
+
+	if err := r.runCommands(ctx, commands); err != nil {
+		diags.AddError("failed to apply the security baseline", err.Error())
+	}
+	return diags
+}
+
+// containsCommand checks if the privilege command is in the list regardless of its formatting.
+func containsCommand(commands []string, command string) bool {
+	for _, c := range commands {
+		if normalizePrivilegeCommand(c) == normalizePrivilegeCommand(command) {
+			return true
+		}
+	}
+	return false
+}
+
+// showPrivileges defines the privileges shown by `SHOW PRIVILEGES AS COMMANDS` for the GRANT command,
+// e.g. MATCH is shown as TRAVERSE and READ, and the graph privileges are shown for the nodes and the relationships.
+// The command's privilege is granted to the temporary role to show it.
+func (r *SecurityBaselineResource) showPrivileges(ctx context.Context, command string) (privileges []string,
+	err error) {
+	privilege, role := splitRole(command)
+	probe := "terraform_probe_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	if _, err := r.client.RunSystem(ctx, "CREATE ROLE "+quoteName(probe), nil); err != nil {
+		return nil, err
+	}
+	defer func() {
+		ctx, cancel := cleanupContext(ctx)
+		defer cancel()
+		if _, dropErr := r.client.RunSystem(ctx, "DROP ROLE "+quoteName(probe), nil); dropErr != nil {
+			err = errors.Join(err, dropErr)
+		}
+	}()
+
+	if _, err := r.client.RunSystem(ctx, privilege+" TO "+quoteName(probe), nil); err != nil {
+		return nil, err
+	}
+	dbResp, err := r.client.RunSystem(ctx, `SHOW ROLE $role PRIVILEGES AS COMMANDS YIELD command RETURN command`,
+		map[string]any{"role": probe})
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range dbResp.Records {
+		shown, _ := splitRole(fmt.Sprintf("%v", rec.Values[0]))
+		privileges = append(privileges, normalizePrivilegeCommand(shown+" TO "+role))
+	}
+	return privileges, nil
+}
+
+// showAllPrivileges defines the privileges shown for every command.
+func (r *SecurityBaselineResource) showAllPrivileges(ctx context.Context, commands []string) (map[string][]string,
+	error) {
+	var o = make(map[string][]string, len(commands))
+	for _, command := range commands {
+		privileges, err := r.showPrivileges(ctx, command)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", command, err)
+		}
+		o[command] = privileges
+	}
+	return o, nil
+}
+
+// grantedPrivileges keeps the configured commands which privileges are all granted.
+// The command is compared as is if its privileges were not shown when it was applied.
+func grantedPrivileges(configured []string, shown map[string][]string, granted []string) []string {
+	var o = make([]string, 0, len(configured))
+	for _, command := range configured {
+		privileges, ok := shown[command]
+		if !ok {
+			privileges = []string{command}
+		}
+		if !slices.ContainsFunc(privileges, func(p string) bool { return !containsCommand(granted, p) }) {
+			o = append(o, command)
+		}
+	}
+	return o
+}
+
+// setShownPrivileges keeps the privileges shown for every configured command in the private state.
+func (r *SecurityBaselineResource) setShownPrivileges(ctx context.Context, data SecurityBaselineResourceModel,
+	private interface {
+		SetKey(context.Context, string, []byte) diag.Diagnostics
+	}) (diags diag.Diagnostics) {
+	var commands []string
+	if !data.Privileges.IsNull() {
+		diags.Append(data.Privileges.ElementsAs(ctx, &commands, false)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+	shown, err := r.showAllPrivileges(ctx, commands)
+	if err != nil {
+		diags.AddError("failed to show the privileges", err.Error())
+		return diags
+	}
+	v, err := json.Marshal(shown)
+	if err != nil {
+		diags.AddError("failed to show the privileges", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, privateKeyPrivileges, v)
+}
+
+func (r *SecurityBaselineResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+	var data SecurityBaselineResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+securityBaselineSuffix, auditOperationCreate, nil)
+
+	tflog.Trace(ctx, "apply the security baseline")
+	resp.Diagnostics.Append(r.apply(ctx, data, nil)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Debug(ctx, "failed to apply the security baseline")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.setShownPrivileges(ctx, data, resp.Private)...)
+	tflog.Trace(ctx, "applied the security baseline")
+}
+
+func (r *SecurityBaselineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SecurityBaselineResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "reading the security baseline")
+
+	dbResp, err := r.client.RunSystem(ctx, `SHOW PRIVILEGES AS COMMANDS YIELD command RETURN command`, nil)
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the privileges")
+		resp.Diagnostics.AddError("failed to read the privileges", err.Error())
+		return
+	}
+	var granted = make([]string, len(dbResp.Records))
+	for i, rec := range dbResp.Records {
+		granted[i] = fmt.Sprintf("%v", rec.Values[0])
+	}
+
+	// The baseline is drifted if any of the default privileges is granted to the PUBLIC role.
+	var revoked = true
+	for _, command := range publicPrivileges {
+		if containsCommand(granted, command) {
+			revoked = false
+		}
+	}
+	data.RevokePublic = types.BoolValue(revoked)
+
+	if !data.Privileges.IsNull() {
+		var configured []string
+		resp.Diagnostics.Append(data.Privileges.ElementsAs(ctx, &configured, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		var shown map[string][]string
+		v, diags := req.Private.GetKey(ctx, privateKeyPrivileges)
+		resp.Diagnostics.Append(diags...)
+		if len(v) > 0 {
+			if err := json.Unmarshal(v, &shown); err != nil {
+				resp.Diagnostics.AddError("failed to read the shown privileges", err.Error())
+			}
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// Only the privileges which are still granted are kept to plan granting the revoked ones.
+		data.Privileges, diags = types.ListValueFrom(ctx, types.StringType, grantedPrivileges(configured, shown, granted))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the security baseline")
+}
+
+func (r *SecurityBaselineResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+	var plan, state SecurityBaselineResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+securityBaselineSuffix, auditOperationUpdate, nil)
+
+	tflog.Trace(ctx, "update the security baseline")
+	resp.Diagnostics.Append(r.apply(ctx, plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Debug(ctx, "failed to update the security baseline")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.setShownPrivileges(ctx, plan, resp.Private)...)
+	tflog.Trace(ctx, "updated the security baseline")
+}
+
+func (r *SecurityBaselineResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+	var data SecurityBaselineResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+securityBaselineSuffix, auditOperationDelete, nil)
+
+	var privileges []string
+	if !data.Privileges.IsNull() {
+		resp.Diagnostics.Append(data.Privileges.ElementsAs(ctx, &privileges, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var commands []string
+	for _, command := range privileges {
+		commands = append(commands, revokeCommand(command))
+	}
+	if data.RevokePublic.ValueBool() {
+		commands = append(commands, publicPrivileges...)
+	}
+
+	tflog.Trace(ctx, "delete the security baseline")
+	if err := r.runCommands(ctx, commands); err != nil {
+		tflog.Debug(ctx, "failed to delete the security baseline")
+		resp.Diagnostics.AddError("failed to delete the security baseline", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "deleted the security baseline")
+}
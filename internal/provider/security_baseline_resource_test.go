@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevokeCommand(t *testing.T) {
+	assert.Equal(t, "REVOKE GRANT ACCESS ON HOME DATABASE FROM PUBLIC",
+		revokeCommand("GRANT ACCESS ON HOME DATABASE TO PUBLIC"))
+	assert.Equal(t, "REVOKE GRANT TRAVERSE ON GRAPH movies NODE Person FROM reader",
+		revokeCommand("GRANT  TRAVERSE ON GRAPH movies NODE Person\n  to reader"))
+}
+
+func TestContainsCommand(t *testing.T) {
+	granted := []string{"GRANT ACCESS ON DATABASE `movies` TO `reader`"}
+	assert.True(t, containsCommand(granted, "GRANT ACCESS ON DATABASE movies TO reader"))
+	assert.False(t, containsCommand(granted, "GRANT ACCESS ON DATABASE movies TO Reader"),
+		"the role names are case-sensitive")
+	assert.False(t, containsCommand(granted, "GRANT ACCESS ON DATABASE * TO reader"))
+}
+
+func TestGrantedPrivileges(t *testing.T) {
+	const match = "grant match {*} on graph movies to reader"
+	shown := map[string][]string{match: {
+		"GRANT TRAVERSE ON GRAPH movies NODE * TO reader",
+		"GRANT TRAVERSE ON GRAPH movies RELATIONSHIP * TO reader",
+		"GRANT READ {*} ON GRAPH movies NODE * TO reader",
+		"GRANT READ {*} ON GRAPH movies RELATIONSHIP * TO reader",
+	}}
+	granted := []string{
+		"GRANT ACCESS ON DATABASE `movies` TO `reader`",
+		"GRANT TRAVERSE ON GRAPH `movies` NODE * TO `reader`",
+		"GRANT TRAVERSE ON GRAPH `movies` RELATIONSHIP * TO `reader`",
+		"GRANT READ {*} ON GRAPH `movies` NODE * TO `reader`",
+		"GRANT READ {*} ON GRAPH `movies` RELATIONSHIP * TO `reader`",
+	}
+	configured := []string{match, "GRANT ACCESS ON DATABASE movies TO reader"}
+
+	// the expanded form is compared to the privileges shown when the command was applied
+	assert.Equal(t, configured, grantedPrivileges(configured, shown, granted))
+	// the command is kept only if all its privileges are granted
+	assert.Equal(t, []string{"GRANT ACCESS ON DATABASE movies TO reader"},
+		grantedPrivileges(configured, shown, granted[:4]))
+	// the command without the shown privileges is compared as is
+	assert.Equal(t, []string{"GRANT ACCESS ON DATABASE movies TO reader"},
+		grantedPrivileges(configured, nil, granted))
+}
+
+func TestSplitRole(t *testing.T) {
+	privilege, role := splitRole("GRANT READ {*} ON GRAPH `movies` NODE * TO `reader`")
+	assert.Equal(t, "GRANT READ {*} ON GRAPH `movies` NODE *", privilege)
+	assert.Equal(t, "`reader`", role)
+}
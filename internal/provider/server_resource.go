@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ServerResource{}
+var _ resource.ResourceWithImportState = &ServerResource{}
+
+func NewServerResource() resource.Resource {
+	return &ServerResource{}
+}
+
+// ServerResource defines the resource to manage the options of the cluster server.
+type ServerResource struct {
+	client *Client
+}
+
+// ServerResourceModel describes the resource data model.
+type ServerResourceModel struct {
+	Name             types.String `tfsdk:"name"`
+	Tags             types.List   `tfsdk:"tags"`
+	AllowedDatabases types.List   `tfsdk:"allowed_databases"`
+	DeniedDatabases  types.List   `tfsdk:"denied_databases"`
+	ModeConstraint   types.String `tfsdk:"mode_constraint"`
+}
+
+const (
+	serverSuffix = "_server"
+
+	modeConstraintNone      = "NONE"
+	modeConstraintPrimary   = "PRIMARY"
+	modeConstraintSecondary = "SECONDARY"
+)
+
+// alterServerQuery defines the command to set the server options.
+// The options which are not set are reset by the command, hence all managed options are always passed.
+func alterServerQuery(options []string) string {
+	var o = make([]string, len(options))
+	for i, k := range options {
+		o[i] = k + ": $" + k
+	}
+	return "ALTER SERVER $name SET OPTIONS {" + strings.Join(o, ", ") + "}"
+}
+
+func (r *ServerResource) Metadata(_ context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + serverSuffix
+}
+
+func (r *ServerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the options of the cluster server to define the databases placement policy, details: " +
+			"https://neo4j.com/docs/operations-manual/current/clustering/servers/#alter-server-options" +
+			"\n\nThe server is neither enabled, nor dropped by the resource, its options are reset on destroy." +
+			"\n\n!>**Warning** The resource requires the Neo4j Enterprise Edition.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The server name.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "The server tags used by the topology graph placement policies.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators:          []validator.List{listvalidator.UniqueValues()},
+			},
+			"allowed_databases": schema.ListAttribute{
+				MarkdownDescription: "The databases allowed to be hosted on the server.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ConflictsWith(path.MatchRoot("denied_databases")),
+				},
+			},
+			"denied_databases": schema.ListAttribute{
+				MarkdownDescription: "The databases denied to be hosted on the server.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators:          []validator.List{listvalidator.UniqueValues()},
+			},
+			"mode_constraint": schema.StringAttribute{
+				MarkdownDescription: "The mode the databases can be hosted in on the server: " +
+					"`NONE`, `PRIMARY`, or `SECONDARY`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(modeConstraintNone),
+				Validators: []validator.String{
+					stringvalidator.OneOf(modeConstraintNone, modeConstraintPrimary, modeConstraintSecondary),
+				},
+			},
+		},
+	}
+}
+
+func (r *ServerResource) Configure(_ context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// alter sets the server options.
+func (r *ServerResource) alter(ctx context.Context, data ServerResourceModel) (diags diag.Diagnostics) {
+	var params = map[string]any{
+		"name":           data.Name.ValueString(),
+		"modeConstraint": data.ModeConstraint.ValueString(),
+	}
+	var options = []string{"modeConstraint"}
+	for _, v := range []struct {
+		key  string
+		list types.List
+	}{
+		{"tags", data.Tags},
+		{"allowedDatabases", data.AllowedDatabases},
+		{"deniedDatabases", data.DeniedDatabases},
+	} {
+		if v.list.IsNull() {
+			continue
+		}
+		var values []string
+		diags.Append(v.list.ElementsAs(ctx, &values, false)...)
+		params[v.key] = values
+		options = append(options, v.key)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if _, err := r.client.RunSystem(ctx, alterServerQuery(options), params); err != nil {
+		diags.AddError("failed to set the server options", err.Error())
+	}
+	return diags
+}
+
+func (r *ServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ServerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+serverSuffix, auditOperationCreate, &data.Name)
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "set the server options", props)
+
+	resp.Diagnostics.Append(r.alter(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Debug(ctx, "failed to set the server options", props)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "set the server options", props)
+}
+
+// readList sets the list read from the database, the empty list is kept null if not configured.
+func readList(ctx context.Context, target *types.List, v any) (diags diag.Diagnostics) {
+	values, _ := v.([]any)
+	if len(values) == 0 && target.IsNull() {
+		return diags
+	}
+
+	var current []string
+	if !target.IsNull() {
+		diags.Append(target.ElementsAs(ctx, &current, false)...)
+	}
+	var read = make([]string, len(values))
+	for i, el := range values {
+		read[i] = fmt.Sprintf("%v", el)
+	}
+	// The order is not significant.
+	if sameElements(current, read) {
+		return diags
+	}
+	var d diag.Diagnostics
+	*target, d = types.ListValueFrom(ctx, types.StringType, read)
+	diags.Append(d...)
+	return diags
+}
+
+func (r *ServerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ServerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "reading the server options", props)
+
+	dbResp, err := r.client.RunSystem(ctx, `SHOW SERVERS
+YIELD name, tags, allowedDatabases, deniedDatabases, modeConstraint
+WHERE name = $name
+RETURN tags, allowedDatabases, deniedDatabases, modeConstraint`, map[string]any{"name": data.Name.ValueString()})
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the server options", props)
+		resp.Diagnostics.AddError("failed to read the server options", err.Error())
+		return
+	}
+	if len(dbResp.Records) == 0 {
+		tflog.Debug(ctx, "no server found", props)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	m := dbResp.Records[0].AsMap()
+	resp.Diagnostics.Append(readList(ctx, &data.Tags, m["tags"])...)
+	resp.Diagnostics.Append(readList(ctx, &data.AllowedDatabases, m["allowedDatabases"])...)
+	resp.Diagnostics.Append(readList(ctx, &data.DeniedDatabases, m["deniedDatabases"])...)
+	data.ModeConstraint = types.StringValue(modeConstraintNone)
+	if v, ok := m["modeConstraint"].(string); ok && slices.Contains(
+		[]string{modeConstraintPrimary, modeConstraintSecondary}, strings.ToUpper(v)) {
+		data.ModeConstraint = types.StringValue(strings.ToUpper(v))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the server options", props)
+}
+
+func (r *ServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ServerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+serverSuffix, auditOperationUpdate, &data.Name)
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "update the server options", props)
+
+	resp.Diagnostics.Append(r.alter(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Debug(ctx, "failed to update the server options", props)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "updated the server options", props)
+}
+
+func (r *ServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ServerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.guardApply(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.client.audit(ctx, &resp.Diagnostics, Name+serverSuffix, auditOperationDelete, &data.Name)
+
+	props := map[string]interface{}{"name": data.Name.ValueString()}
+	tflog.Trace(ctx, "reset the server options", props)
+
+	resp.Diagnostics.Append(r.alter(ctx, ServerResourceModel{
+		Name:             data.Name,
+		Tags:             types.ListNull(types.StringType),
+		AllowedDatabases: types.ListNull(types.StringType),
+		DeniedDatabases:  types.ListNull(types.StringType),
+		ModeConstraint:   types.StringValue(modeConstraintNone),
+	})...)
+	if resp.Diagnostics.HasError() {
+		tflog.Debug(ctx, "failed to reset the server options", props)
+		return
+	}
+	tflog.Trace(ctx, "reset the server options", props)
+}
+
+func (r *ServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
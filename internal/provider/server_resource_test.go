@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlterServerQuery(t *testing.T) {
+	assert.Equal(t, "ALTER SERVER $name SET OPTIONS {modeConstraint: $modeConstraint}",
+		alterServerQuery([]string{"modeConstraint"}))
+	assert.Equal(t, "ALTER SERVER $name SET OPTIONS {modeConstraint: $modeConstraint, tags: $tags}",
+		alterServerQuery([]string{"modeConstraint", "tags"}))
+}
+
+func TestReadList(t *testing.T) {
+	ctx := context.Background()
+
+	list := types.ListNull(types.StringType)
+	assert.False(t, readList(ctx, &list, []any{}).HasError())
+	assert.True(t, list.IsNull(), "the empty list shall be kept null if not configured")
+
+	list = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("eu"), types.StringValue("ssd")})
+	assert.False(t, readList(ctx, &list, []any{"ssd", "eu"}).HasError())
+	assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("eu"), types.StringValue("ssd"),
+	}), list, "the order shall be kept")
+
+	assert.False(t, readList(ctx, &list, []any{"us"}).HasError())
+	assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{types.StringValue("us")}), list)
+}
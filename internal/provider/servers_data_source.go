@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ServersDataSource{}
+
+func NewServersDataSource() datasource.DataSource {
+	return &ServersDataSource{}
+}
+
+// ServersDataSource defines the `SHOW SERVERS` data source implementation.
+type ServersDataSource struct {
+	client *Client
+}
+
+// ServersDataSourceModel describes the data source data model.
+type ServersDataSourceModel struct {
+	Servers types.List `tfsdk:"servers"`
+}
+
+// ServerModel describes the server of the cluster.
+type ServerModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Address          types.String `tfsdk:"address"`
+	State            types.String `tfsdk:"state"`
+	Health           types.String `tfsdk:"health"`
+	Hosting          types.List   `tfsdk:"hosting"`
+	Tags             types.List   `tfsdk:"tags"`
+	AllowedDatabases types.List   `tfsdk:"allowed_databases"`
+	DeniedDatabases  types.List   `tfsdk:"denied_databases"`
+	ModeConstraint   types.String `tfsdk:"mode_constraint"`
+}
+
+var serverAttrTypes = map[string]attr.Type{
+	"id":                types.StringType,
+	"name":              types.StringType,
+	"address":           types.StringType,
+	"state":             types.StringType,
+	"health":            types.StringType,
+	"hosting":           types.ListType{ElemType: types.StringType},
+	"tags":              types.ListType{ElemType: types.StringType},
+	"allowed_databases": types.ListType{ElemType: types.StringType},
+	"denied_databases":  types.ListType{ElemType: types.StringType},
+	"mode_constraint":   types.StringType,
+}
+
+const serversSuffix = "_servers"
+
+const serversQuery = `SHOW SERVERS
+YIELD serverId, name, address, state, health, hosting, tags, allowedDatabases, deniedDatabases, modeConstraint
+RETURN serverId, name, address, state, health, hosting, tags, allowedDatabases, deniedDatabases, modeConstraint
+ORDER BY name`
+
+func (d *ServersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + serversSuffix
+}
+
+func (d *ServersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	listAttribute := func(description string) schema.Attribute {
+		return schema.ListAttribute{
+			MarkdownDescription: description,
+			Computed:            true,
+			ElementType:         types.StringType,
+		}
+	}
+	stringAttribute := func(description string) schema.Attribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description,
+			Computed:            true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Neo4j cluster servers, their tags and the databases placement constraints, details: " +
+			"https://neo4j.com/docs/operations-manual/current/clustering/servers/" +
+			"\n\n!>**Warning** The data source requires the Neo4j Enterprise Edition.",
+		Attributes: map[string]schema.Attribute{
+			"servers": schema.ListNestedAttribute{
+				MarkdownDescription: "The servers ordered by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":      stringAttribute("The server identifier."),
+						"name":    stringAttribute("The server name."),
+						"address": stringAttribute("The server's Bolt address."),
+						"state": stringAttribute("The server state: `Free`, `Enabled`, " +
+							"`Deallocating`, `Cordoned`, or `Dropped`."),
+						"health":            stringAttribute("The server health: `Available`, or `Unavailable`."),
+						"hosting":           listAttribute("The databases hosted on the server."),
+						"tags":              listAttribute("The server tags."),
+						"allowed_databases": listAttribute("The databases allowed to be hosted on the server."),
+						"denied_databases":  listAttribute("The databases denied to be hosted on the server."),
+						"mode_constraint": stringAttribute("The mode the databases can be hosted in: " +
+							"`NONE`, `PRIMARY`, or `SECONDARY`."),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ServersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// toServerModel converts the `SHOW SERVERS` record to the server model.
+func toServerModel(ctx context.Context, m map[string]any) (server ServerModel, diags diag.Diagnostics) {
+	server = ServerModel{
+		ID:             toStringValue(m["serverId"]),
+		Name:           toStringValue(m["name"]),
+		Address:        toStringValue(m["address"]),
+		State:          toStringValue(m["state"]),
+		Health:         toStringValue(m["health"]),
+		ModeConstraint: toStringValue(m["modeConstraint"]),
+	}
+	for _, v := range []struct {
+		key    string
+		target *types.List
+	}{
+		{"hosting", &server.Hosting},
+		{"tags", &server.Tags},
+		{"allowedDatabases", &server.AllowedDatabases},
+		{"deniedDatabases", &server.DeniedDatabases},
+	} {
+		var d diag.Diagnostics
+		*v.target, d = toLabels(ctx, m[v.key])
+		diags.Append(d...)
+	}
+	return server, diags
+}
+
+func (d *ServersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ServersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "reading the servers")
+
+	dbResp, err := d.client.RunSystem(ctx, serversQuery, nil)
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the servers")
+		resp.Diagnostics.AddError("failed to read the servers", err.Error())
+		return
+	}
+
+	var servers = make([]ServerModel, len(dbResp.Records))
+	for i, rec := range dbResp.Records {
+		var diags diag.Diagnostics
+		servers[i], diags = toServerModel(ctx, rec.AsMap())
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.Servers, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: serverAttrTypes}, servers)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the servers", map[string]interface{}{"count": len(servers)})
+}
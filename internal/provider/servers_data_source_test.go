@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToServerModel(t *testing.T) {
+	ctx := context.Background()
+	server, diags := toServerModel(ctx, map[string]any{
+		"serverId":         "d6fbe54b-0001",
+		"name":             "server-1",
+		"address":          "localhost:7687",
+		"state":            "Enabled",
+		"health":           "Available",
+		"hosting":          []any{"neo4j", "system"},
+		"tags":             []any{"eu", "ssd"},
+		"allowedDatabases": []any{},
+		"deniedDatabases":  []any{"movies"},
+		"modeConstraint":   "NONE",
+	})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, types.StringValue("server-1"), server.Name)
+	assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("eu"), types.StringValue("ssd"),
+	}), server.Tags)
+	assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{}), server.AllowedDatabases)
+	assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{types.StringValue("movies")}),
+		server.DeniedDatabases)
+	assert.Equal(t, types.StringValue("NONE"), server.ModeConstraint)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SettingsDataSource{}
+
+func NewSettingsDataSource() datasource.DataSource {
+	return &SettingsDataSource{}
+}
+
+// SettingsDataSource defines the `SHOW SETTINGS` data source implementation.
+type SettingsDataSource struct {
+	client *Client
+}
+
+// SettingsDataSourceModel describes the data source data model.
+type SettingsDataSourceModel struct {
+	NamePattern types.String `tfsdk:"name_pattern"`
+	Settings    types.Map    `tfsdk:"settings"`
+}
+
+const settingsSuffix = "_settings"
+
+func (d *SettingsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + settingsSuffix
+}
+
+func (d *SettingsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Neo4j DBMS configuration settings, details: " +
+			"https://neo4j.com/docs/operations-manual/current/configuration/dynamic-settings/",
+		Attributes: map[string]schema.Attribute{
+			"name_pattern": schema.StringAttribute{
+				MarkdownDescription: "Regular expression to filter the settings by name. " +
+					"All settings are returned if not set.",
+				Optional: true,
+			},
+			"settings": schema.MapAttribute{
+				MarkdownDescription: "The settings values by the settings names.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *SettingsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SettingsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pattern := cmp.Or(data.NamePattern.ValueString(), ".*")
+	tflog.Trace(ctx, "reading the settings", map[string]interface{}{"name_pattern": pattern})
+
+	dbResp, err := d.client.Run(ctx, `SHOW SETTINGS YIELD name, value WHERE name =~ $pattern RETURN name, value`,
+		map[string]any{"pattern": pattern})
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the settings")
+		resp.Diagnostics.AddError("failed to read the settings", err.Error())
+		return
+	}
+
+	var settings = make(map[string]string)
+	var rec *neo4j.Record
+	for dbResp.NextRecord(ctx, &rec) {
+		m := rec.AsMap()
+		var v string
+		if m["value"] != nil {
+			v = fmt.Sprintf("%v", m["value"])
+		}
+		settings[m["name"].(string)] = v
+	}
+	if err := dbResp.Err(); err != nil {
+		tflog.Debug(ctx, "failed to read the settings")
+		resp.Diagnostics.AddError("failed to read the settings", err.Error())
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.Settings, diags = types.MapValueFrom(ctx, types.StringType, settings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the settings", map[string]interface{}{"count": len(settings)})
+}
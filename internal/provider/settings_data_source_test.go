@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccSettingsDataSource(t *testing.T) {
+	t.Setenv("DB_URI", testDbURI)
+	t.Setenv("DB_USER", testDBUser)
+	t.Cleanup(func() {
+		t.Setenv("DB_URI", "")
+		t.Setenv("DB_USER", "")
+	})
+
+	const dataSourceAddress = "data." + Name + settingsSuffix + "._"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "neo4j_settings" "_" {
+name_pattern = "dbms\\.security\\.auth_enabled"
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					// the test container runs with disabled authentication
+					statecheck.ExpectKnownValue(
+						dataSourceAddress,
+						tfjsonpath.New("settings"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"dbms.security.auth_enabled": knownvalue.StringExact("false"),
+						}),
+					),
+				},
+			},
+			{
+				Config: `data "neo4j_settings" "_" {}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						dataSourceAddress,
+						tfjsonpath.New("settings").AtMapKey("dbms.security.auth_enabled"),
+						knownvalue.StringExact("false"),
+					),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResourceWithConfigure = &TemporaryCredentialsEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &TemporaryCredentialsEphemeralResource{}
+
+func NewTemporaryCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &TemporaryCredentialsEphemeralResource{}
+}
+
+// TemporaryCredentialsEphemeralResource defines the ephemeral resource to mint the credentials valid during the run.
+type TemporaryCredentialsEphemeralResource struct {
+	client *Client
+}
+
+// TemporaryCredentialsEphemeralResourceModel describes the ephemeral resource data model.
+type TemporaryCredentialsEphemeralResourceModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Roles      types.List   `tfsdk:"roles"`
+	Username   types.String `tfsdk:"username"`
+	Password   types.String `tfsdk:"password"`
+}
+
+const temporaryCredentialsSuffix = "_temporary_credentials"
+
+// privateKeyUsername is the private data key of the temporary user's name to drop when the credentials are closed.
+const privateKeyUsername = "username"
+
+// randomString generates the random string of n bytes encoded with the encoder.
+func randomString(n int, encode func([]byte) string) (string, error) {
+	var b = make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return encode(b), nil
+}
+
+func (r *TemporaryCredentialsEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest,
+	resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + temporaryCredentialsSuffix
+}
+
+func (r *TemporaryCredentialsEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest,
+	resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Mints the credentials which are valid only during the Terraform run, " +
+			"e.g. to pass them to the application deployed in the same apply. " +
+			"The temporary user is created when the credentials are opened and dropped when they are closed. " +
+			"If Terraform is interrupted before the credentials are closed, e.g. the provider crashes, " +
+			"the user and its roles are left in the database: Neo4j does not expire the users, " +
+			"hence drop the leftover users with the `name_prefix` manually." +
+			"\n\n-> **Note** The roles can only be granted in the Neo4j Enterprise Edition. " +
+			"The provider's user must be allowed to manage the users.",
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "The prefix of the temporary user's name, defaults to `tf_`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"roles": schema.ListAttribute{
+				MarkdownDescription: "The roles to grant to the temporary user.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The temporary user's name.",
+				Computed:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The temporary user's password, e.g. generated by the ephemeral resource " +
+					"`neo4j_generated_password` to comply with the password policy. Generated if not set.",
+				Optional:   true,
+				Computed:   true,
+				Sensitive:  true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+		},
+	}
+}
+
+func (r *TemporaryCredentialsEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest,
+	resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TemporaryCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest,
+	resp *ephemeral.OpenResponse) {
+	var data TemporaryCredentialsEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var roles []string
+	resp.Diagnostics.Append(data.Roles.ElementsAs(ctx, &roles, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	suffix, err := randomString(8, hex.EncodeToString)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to generate the user name", err.Error())
+		return
+	}
+	password := data.Password.ValueString()
+	if data.Password.IsNull() {
+		if password, err = randomString(24, base64.RawURLEncoding.EncodeToString); err != nil {
+			resp.Diagnostics.AddError("failed to generate the password", err.Error())
+			return
+		}
+	}
+	ctx = r.client.maskSensitive(ctx, password)
+
+	username := data.NamePrefix.ValueString()
+	if data.NamePrefix.IsNull() {
+		username = "tf_"
+	}
+	username += suffix
+
+	tflog.Trace(ctx, "creating the temporary user", map[string]interface{}{"username": username})
+
+	if _, err := r.client.RunSystem(ctx, `CREATE USER $name SET PASSWORD $password CHANGE NOT REQUIRED`,
+		map[string]any{"name": username, "password": password}); err != nil {
+		resp.Diagnostics.AddError("failed to create the temporary user", err.Error())
+		return
+	}
+
+	// Terraform doesn't close the ephemeral resource which failed to open, hence the user is dropped here.
+	defer func() {
+		if !resp.Diagnostics.HasError() {
+			return
+		}
+		ctx, cancel := cleanupContext(ctx)
+		defer cancel()
+		if err := r.dropUser(ctx, username); err != nil {
+			resp.Diagnostics.AddError("failed to drop the temporary user",
+				fmt.Sprintf("the user %s shall be dropped manually: %v", username, err))
+		}
+	}()
+
+	// The user is dropped on close, hence its name is kept before anything else can fail.
+	name, err := json.Marshal(username)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to store the temporary user", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyUsername, name)...)
+
+	for _, role := range roles {
+		if _, err := r.client.RunSystem(ctx, `GRANT ROLE $role TO $name`,
+			map[string]any{"role": role, "name": username}); err != nil {
+			resp.Diagnostics.AddError("failed to grant the role to the temporary user",
+				fmt.Sprintf("role %s: %v", role, err))
+			return
+		}
+	}
+
+	data.Username = types.StringValue(username)
+	data.Password = types.StringValue(password)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	tflog.Trace(ctx, "created the temporary user", map[string]interface{}{"username": username})
+}
+
+func (r *TemporaryCredentialsEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest,
+	resp *ephemeral.CloseResponse) {
+	v, diags := req.Private.GetKey(ctx, privateKeyUsername)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(v) == 0 {
+		return
+	}
+
+	var username string
+	if err := json.Unmarshal(v, &username); err != nil {
+		resp.Diagnostics.AddError("failed to read the temporary user", err.Error())
+		return
+	}
+
+	if err := r.dropUser(ctx, username); err != nil {
+		resp.Diagnostics.AddError("failed to drop the temporary user", err.Error())
+	}
+}
+
+// dropUser drops the temporary user.
+func (r *TemporaryCredentialsEphemeralResource) dropUser(ctx context.Context, username string) error {
+	tflog.Trace(ctx, "dropping the temporary user", map[string]interface{}{"username": username})
+	if _, err := r.client.RunSystem(ctx, `DROP USER $name IF EXISTS`, map[string]any{"name": username}); err != nil {
+		return err
+	}
+	tflog.Trace(ctx, "dropped the temporary user", map[string]interface{}{"username": username})
+	return nil
+}
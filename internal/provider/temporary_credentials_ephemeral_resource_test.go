@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRandomString(t *testing.T) {
+	a, err := randomString(8, hex.EncodeToString)
+	assert.NoError(t, err)
+	assert.Len(t, a, 16)
+
+	b, err := randomString(8, hex.EncodeToString)
+	assert.NoError(t, err)
+	assert.NotEqual(t, a, b)
+
+	password, err := randomString(24, base64.RawURLEncoding.EncodeToString)
+	assert.NoError(t, err)
+	assert.Len(t, password, 32)
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// transactionGuardDefaultThreshold is the default elapsed time of the long-running transaction.
+	transactionGuardDefaultThreshold = 30 * time.Second
+	transactionGuardPollInterval     = 2 * time.Second
+)
+
+// transactionGuard holds back the apply while the database runs too many long-running transactions.
+type transactionGuard struct {
+	// limit is the maximum number of the long-running transactions.
+	limit int64
+	// threshold is the elapsed time after which the transaction is considered long-running.
+	threshold time.Duration
+	// wait is the maximum time to wait for the transactions to finish, the apply is aborted right away if zero.
+	wait time.Duration
+
+	// The guard is checked once before the first change is applied,
+	// the check cancelled with the change's context is retried by the next change.
+	mu      sync.Mutex
+	checked bool
+	err     error
+}
+
+// newTransactionGuard defines the guard, the durations are parsed from the provider configuration.
+func newTransactionGuard(limit int64, threshold, wait string) (*transactionGuard, error) {
+	var g = &transactionGuard{limit: limit, threshold: transactionGuardDefaultThreshold}
+	var err error
+	if threshold != "" {
+		if g.threshold, err = time.ParseDuration(threshold); err != nil || g.threshold < 0 {
+			return nil, fmt.Errorf("invalid transaction guard threshold, expected the duration, e.g. 30s, got: %s",
+				threshold)
+		}
+	}
+	if wait != "" {
+		if g.wait, err = time.ParseDuration(wait); err != nil || g.wait < 0 {
+			return nil, fmt.Errorf("invalid transaction guard wait, expected the duration, e.g. 5m, got: %s", wait)
+		}
+	}
+	return g, nil
+}
+
+// longRunningTransactions counts the transactions which run on the database longer than the threshold.
+func (c *Client) longRunningTransactions(ctx context.Context, threshold time.Duration) (int64, error) {
+	database, err := c.currentDatabase(ctx)
+	if err != nil {
+		return 0, err
+	}
+	dbResp, err := c.Run(ctx, `SHOW TRANSACTIONS YIELD database, elapsedTime
+WHERE database = $database AND elapsedTime.milliseconds >= $threshold
+RETURN count(*)`, map[string]any{"database": database, "threshold": threshold.Milliseconds()})
+	if err != nil {
+		return 0, err
+	}
+	rec, err := dbResp.Single(ctx)
+	if err != nil {
+		return 0, err
+	}
+	n, _ := rec.Values[0].(int64)
+	return n, nil
+}
+
+// check waits until the number of the long-running transactions is within the limit.
+func (g *transactionGuard) check(ctx context.Context, c *Client) error {
+	deadline := time.Now().Add(g.wait)
+	for {
+		n, err := c.longRunningTransactions(ctx, g.threshold)
+		if err != nil {
+			return fmt.Errorf("failed to read the transactions: %w", err)
+		}
+		if n <= g.limit {
+			return nil
+		}
+		if !time.Now().Before(deadline) {
+			return fmt.Errorf("%d transactions run longer than %s, the limit is %d, waited for %s",
+				n, g.threshold, g.limit, g.wait)
+		}
+
+		tflog.Debug(ctx, "waiting for the long-running transactions to finish", map[string]interface{}{"count": n})
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(transactionGuardPollInterval):
+		}
+	}
+}
+
+// guardApply checks the transaction guard before the change is applied, it's no-op if the guard is not configured.
+func (c *Client) guardApply(ctx context.Context) (diags diag.Diagnostics) {
+	if c == nil || c.transactionGuard == nil {
+		return diags
+	}
+	g := c.transactionGuard
+	g.mu.Lock()
+	if !g.checked {
+		g.err = g.check(ctx, c)
+		g.checked = g.err == nil || ctx.Err() == nil
+	}
+	err := g.err
+	g.mu.Unlock()
+	if err != nil {
+		diags.AddError("too many long-running transactions", err.Error())
+	}
+	return diags
+}
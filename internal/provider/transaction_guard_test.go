@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTransactionGuard(t *testing.T) {
+	g, err := newTransactionGuard(1, "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, transactionGuardDefaultThreshold, g.threshold)
+	assert.Zero(t, g.wait)
+
+	g, err = newTransactionGuard(1, "1m", "5m")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, g.threshold)
+	assert.Equal(t, 5*time.Minute, g.wait)
+
+	_, err = newTransactionGuard(1, "1 minute", "")
+	assert.ErrorContains(t, err, "invalid transaction guard threshold")
+
+	_, err = newTransactionGuard(1, "", "-5m")
+	assert.ErrorContains(t, err, "invalid transaction guard wait")
+}
+
+func TestClientGuardApply(t *testing.T) {
+	ctx := context.Background()
+	assert.False(t, (&Client{}).guardApply(ctx).HasError(), "the guard shall be no-op if not configured")
+
+	newClient := func(limit int64) *Client {
+		c, err := NewClient(ctx, ModelProvider{
+			DatabaseURI:               types.StringValue(testDbURI),
+			DatabaseUser:              types.StringValue(testDBUser),
+			DatabasePassword:          types.StringValue(testDBPass),
+			TransactionGuardLimit:     types.Int64Value(limit),
+			TransactionGuardThreshold: types.StringValue("0s"),
+		})
+		if err != nil {
+			t.Fatalf("could not conenct to database: %v\n", err)
+		}
+		t.Cleanup(func() { _ = c.Close(ctx) })
+		return c
+	}
+
+	// every transaction is long-running with the zero threshold, including the one counting them
+	assert.True(t, newClient(0).guardApply(ctx).HasError())
+	assert.False(t, newClient(100).guardApply(ctx).HasError())
+
+	c := newClient(100)
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.True(t, c.guardApply(cancelled).HasError())
+	assert.False(t, c.guardApply(ctx).HasError(), "the cancelled check shall not be cached")
+}
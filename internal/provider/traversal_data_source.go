@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TraversalDataSource{}
+
+func NewTraversalDataSource() datasource.DataSource {
+	return &TraversalDataSource{}
+}
+
+// TraversalDataSource defines the data source to find the nodes reachable from the start node.
+type TraversalDataSource struct {
+	client *Client
+}
+
+// TraversalDataSourceModel describes the data source data model.
+type TraversalDataSourceModel struct {
+	StartNodeID       types.String `tfsdk:"start_node_id"`
+	RelationshipTypes types.List   `tfsdk:"relationship_types"`
+	Direction         types.String `tfsdk:"direction"`
+	MaxDepth          types.Int64  `tfsdk:"max_depth"`
+	NodeIDs           types.List   `tfsdk:"node_ids"`
+}
+
+const (
+	traversalSuffix = "_traversal"
+
+	// traversalDefaultMaxDepth limits the traversal if the depth is not set.
+	traversalDefaultMaxDepth = 1
+	// traversalMaxDepth limits the traversal to keep the query's cost predictable.
+	traversalMaxDepth = 20
+
+	directionIncoming = "INCOMING"
+	directionBoth     = "BOTH"
+)
+
+// traversalQuery defines the query to find the nodes reachable from the start node.
+// The depth and the direction cannot be passed as the query parameters, hence they are validated by the schema.
+func traversalQuery(direction string, maxDepth int64) string {
+	pattern := fmt.Sprintf("-[*1..%d]-", maxDepth)
+	switch direction {
+	case directionOutgoing:
+		pattern += ">"
+	case directionIncoming:
+		pattern = "<" + pattern
+	}
+	return `MATCH p = (s{uuid:$uuid})` + pattern + `(n)
+WHERE n.uuid IS NOT NULL AND n <> s
+  AND (size($types) = 0 OR all(r IN relationships(p) WHERE type(r) IN $types))
+RETURN DISTINCT n.uuid AS id
+ORDER BY id`
+}
+
+func (d *TraversalDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + traversalSuffix
+}
+
+func (d *TraversalDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Finds the nodes reachable from the start node, " +
+			"e.g. to define the resources per downstream node with `for_each`." +
+			"\n\n-> **Note** Only the nodes with the ID, i.e. the nodes managed by Terraform, are returned.",
+		Attributes: map[string]schema.Attribute{
+			"start_node_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the node to start the traversal from.",
+				Required:            true,
+			},
+			"relationship_types": schema.ListAttribute{
+				MarkdownDescription: "The types of the relationships to traverse. " +
+					"All relationships are traversed if not set.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"direction": schema.StringAttribute{
+				MarkdownDescription: "The direction to traverse the relationships in: " +
+					"`OUTGOING`, `INCOMING`, or `BOTH`. Defaults to `OUTGOING`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(directionOutgoing, directionIncoming, directionBoth),
+				},
+			},
+			"max_depth": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of the relationships to traverse, "+
+					"from 1 to %d. Defaults to %d.", traversalMaxDepth, traversalDefaultMaxDepth),
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.Between(1, traversalMaxDepth)},
+			},
+			"node_ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the reachable nodes.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *TraversalDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TraversalDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+	var data TraversalDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var relationshipTypes = make([]string, 0)
+	if !data.RelationshipTypes.IsNull() {
+		resp.Diagnostics.Append(data.RelationshipTypes.ElementsAs(ctx, &relationshipTypes, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	direction := directionOutgoing
+	if !data.Direction.IsNull() {
+		direction = data.Direction.ValueString()
+	}
+	maxDepth := int64(traversalDefaultMaxDepth)
+	if !data.MaxDepth.IsNull() {
+		maxDepth = data.MaxDepth.ValueInt64()
+	}
+
+	props := map[string]interface{}{"start_node_id": data.StartNodeID.ValueString()}
+	tflog.Trace(ctx, "traversing the graph", props)
+
+	dbResp, err := d.client.RunRead(ctx, traversalQuery(direction, maxDepth), map[string]any{
+		"uuid":  data.StartNodeID.ValueString(),
+		"types": relationshipTypes,
+	})
+	if err != nil {
+		tflog.Debug(ctx, "failed to traverse the graph", props)
+		resp.Diagnostics.AddError("failed to traverse the graph", err.Error())
+		return
+	}
+
+	var ids = make([]string, len(dbResp.Records))
+	for i, rec := range dbResp.Records {
+		ids[i] = fmt.Sprintf("%v", rec.Values[0])
+	}
+	var diags diag.Diagnostics
+	data.NodeIDs, diags = types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "traversed the graph", map[string]interface{}{"count": len(ids)})
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTraversalDataSource(t *testing.T) {
+	t.Setenv("DB_URI", testDbURI)
+	t.Setenv("DB_USER", testDBUser)
+	t.Cleanup(func() {
+		t.Setenv("DB_URI", "")
+		t.Setenv("DB_USER", "")
+	})
+
+	ctx := context.Background()
+	c, err := NewClient(ctx, ModelProvider{
+		DatabaseURI:      types.StringValue(testDbURI),
+		DatabaseUser:     types.StringValue(testDBUser),
+		DatabasePassword: types.StringValue(testDBPass),
+	})
+	if err != nil {
+		t.Errorf("could not conenct to database: %v\n", err)
+		return
+	}
+	t.Cleanup(func() { _ = c.Close(ctx) })
+
+	// gateway -> api -> db, api -> cache, monitoring -> api
+	if _, err = c.Run(ctx, `CREATE (gw:Traversal{uuid:"gateway"}), (api:Traversal{uuid:"api"}),
+(db:Traversal{uuid:"db"}), (cache:Traversal{uuid:"cache"}), (mon:Traversal{uuid:"monitoring"}),
+(gw)-[:CALLS]->(api), (api)-[:CALLS]->(db), (api)-[:USES]->(cache), (mon)-[:WATCHES]->(api)`,
+		nil); err != nil {
+		t.Errorf("could not seed the graph: %v\n", err)
+		return
+	}
+	t.Cleanup(func() {
+		_, _ = c.Run(ctx, `MATCH (n:Traversal) DETACH DELETE n`, nil)
+	})
+
+	const dataSourceAddress = "data." + Name + traversalSuffix + "._"
+
+	ids := func(v ...string) knownvalue.Check {
+		var o = make([]knownvalue.Check, len(v))
+		for i, s := range v {
+			o[i] = knownvalue.StringExact(s)
+		}
+		return knownvalue.ListExact(o)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "neo4j_traversal" "_" {
+start_node_id = "gateway"
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("node_ids"), ids("api")),
+				},
+			},
+			{
+				Config: `data "neo4j_traversal" "_" {
+start_node_id = "gateway"
+max_depth     = 3
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("node_ids"),
+						ids("api", "cache", "db")),
+				},
+			},
+			{
+				Config: `data "neo4j_traversal" "_" {
+start_node_id      = "gateway"
+relationship_types = ["CALLS"]
+max_depth          = 3
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("node_ids"), ids("api", "db")),
+				},
+			},
+			{
+				Config: `data "neo4j_traversal" "_" {
+start_node_id = "api"
+direction     = "INCOMING"
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("node_ids"),
+						ids("gateway", "monitoring")),
+				},
+			},
+			{
+				Config: `data "neo4j_traversal" "_" {
+start_node_id = "db"
+direction     = "BOTH"
+max_depth     = 2
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceAddress, tfjsonpath.New("node_ids"),
+						ids("api", "cache", "gateway", "monitoring")),
+				},
+			},
+		},
+	})
+}
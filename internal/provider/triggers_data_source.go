@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TriggersDataSource{}
+
+func NewTriggersDataSource() datasource.DataSource {
+	return &TriggersDataSource{}
+}
+
+// TriggersDataSource defines the APOC triggers data source implementation.
+type TriggersDataSource struct {
+	client *Client
+}
+
+// TriggersDataSourceModel describes the data source data model.
+type TriggersDataSourceModel struct {
+	Triggers types.List `tfsdk:"triggers"`
+}
+
+// TriggerModel describes the APOC trigger.
+type TriggerModel struct {
+	Name      types.String `tfsdk:"name"`
+	Query     types.String `tfsdk:"query"`
+	Selector  types.String `tfsdk:"selector"`
+	Params    types.String `tfsdk:"params"`
+	Installed types.Bool   `tfsdk:"installed"`
+	Paused    types.Bool   `tfsdk:"paused"`
+}
+
+var triggerAttrTypes = map[string]attr.Type{
+	"name":      types.StringType,
+	"query":     types.StringType,
+	"selector":  types.StringType,
+	"params":    types.StringType,
+	"installed": types.BoolType,
+	"paused":    types.BoolType,
+}
+
+const triggersSuffix = "_triggers"
+
+func (d *TriggersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + triggersSuffix
+}
+
+func (d *TriggersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "APOC triggers installed in the database, details: " +
+			"https://neo4j.com/docs/apoc/current/background-operations/triggers/" +
+			"\n\n!>**Warning** The data source requires the APOC plugin with `apoc.trigger.enabled=true`.",
+		Attributes: map[string]schema.Attribute{
+			"triggers": schema.ListNestedAttribute{
+				MarkdownDescription: "The triggers.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The trigger name.",
+							Computed:            true,
+						},
+						"query": schema.StringAttribute{
+							MarkdownDescription: "The Cypher statement executed by the trigger.",
+							Computed:            true,
+						},
+						"selector": schema.StringAttribute{
+							MarkdownDescription: "JSON encoded selector of the transaction phase to run the trigger at.",
+							Computed:            true,
+						},
+						"params": schema.StringAttribute{
+							MarkdownDescription: "JSON encoded parameters of the trigger's statement.",
+							Computed:            true,
+						},
+						"installed": schema.BoolAttribute{
+							MarkdownDescription: "Whether the trigger is installed.",
+							Computed:            true,
+						},
+						"paused": schema.BoolAttribute{
+							MarkdownDescription: "Whether the trigger is paused.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TriggersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TriggersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TriggersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "reading the triggers")
+
+	dbResp, err := d.client.Run(ctx, `CALL apoc.trigger.list()
+YIELD name, query, selector, params, installed, paused
+RETURN name, query, selector, params, installed, paused
+ORDER BY name`, nil)
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the triggers")
+		resp.Diagnostics.AddError("failed to read the triggers", err.Error())
+		return
+	}
+
+	var triggers = make([]TriggerModel, 0)
+	var rec *neo4j.Record
+	for dbResp.NextRecord(ctx, &rec) {
+		m := rec.AsMap()
+		selector, err := json.Marshal(m["selector"])
+		if err != nil {
+			resp.Diagnostics.AddError("failed to encode the trigger's selector", err.Error())
+			return
+		}
+		params, err := json.Marshal(m["params"])
+		if err != nil {
+			resp.Diagnostics.AddError("failed to encode the trigger's params", err.Error())
+			return
+		}
+		installed, _ := m["installed"].(bool)
+		paused, _ := m["paused"].(bool)
+		triggers = append(triggers, TriggerModel{
+			Name:      toStringValue(m["name"]),
+			Query:     toStringValue(m["query"]),
+			Selector:  types.StringValue(string(selector)),
+			Params:    types.StringValue(string(params)),
+			Installed: types.BoolValue(installed),
+			Paused:    types.BoolValue(paused),
+		})
+	}
+	if err := dbResp.Err(); err != nil {
+		tflog.Debug(ctx, "failed to read the triggers")
+		resp.Diagnostics.AddError("failed to read the triggers", err.Error())
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.Triggers, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: triggerAttrTypes}, triggers)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the triggers", map[string]interface{}{"count": len(triggers)})
+}
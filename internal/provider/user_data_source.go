@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UserDataSource{}
+
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+// UserDataSource defines the data source to look up the database user.
+type UserDataSource struct {
+	client *Client
+}
+
+// UserDataSourceModel describes the data source data model.
+type UserDataSourceModel struct {
+	Name          types.String `tfsdk:"name"`
+	Roles         types.List   `tfsdk:"roles"`
+	Suspended     types.Bool   `tfsdk:"suspended"`
+	HomeDatabase  types.String `tfsdk:"home_database"`
+	AuthProviders types.List   `tfsdk:"auth_providers"`
+}
+
+const userSuffix = "_user"
+
+const userQuery = `SHOW USERS WITH AUTH
+YIELD user, roles, suspended, home, provider
+WHERE user = $name
+RETURN roles, suspended, home, collect(provider)`
+
+func (d *UserDataSource) Metadata(_ context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + userSuffix
+}
+
+func (d *UserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Neo4j database user, details: " +
+			"https://neo4j.com/docs/operations-manual/current/authentication-authorization/manage-users/" +
+			"\n\n-> **Note** The roles, the suspended flag and the home database are only available " +
+			"in the Neo4j Enterprise Edition, they are null otherwise.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The user name.",
+				Required:            true,
+			},
+			"roles": schema.ListAttribute{
+				MarkdownDescription: "The roles granted to the user.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"suspended": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is suspended.",
+				Computed:            true,
+			},
+			"home_database": schema.StringAttribute{
+				MarkdownDescription: "The home database of the user.",
+				Computed:            true,
+			},
+			"auth_providers": schema.ListAttribute{
+				MarkdownDescription: "The auth providers of the user, e.g. `native`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *UserDataSource) Configure(_ context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "reading the user")
+
+	dbResp, err := d.client.RunSystem(ctx, userQuery, map[string]any{"name": data.Name.ValueString()})
+	if err != nil {
+		tflog.Debug(ctx, "failed to read the user")
+		resp.Diagnostics.AddError("failed to read the user", err.Error())
+		return
+	}
+	if len(dbResp.Records) == 0 {
+		resp.Diagnostics.AddError("user not found", fmt.Sprintf("the user %s does not exist", data.Name.ValueString()))
+		return
+	}
+	rec := dbResp.Records[0]
+
+	data.Roles = types.ListNull(types.StringType)
+	if rec.Values[0] != nil {
+		var diags diag.Diagnostics
+		data.Roles, diags = toLabels(ctx, rec.Values[0])
+		resp.Diagnostics.Append(diags...)
+	}
+
+	data.Suspended = types.BoolNull()
+	if v, ok := rec.Values[1].(bool); ok {
+		data.Suspended = types.BoolValue(v)
+	}
+
+	data.HomeDatabase = toStringValue(rec.Values[2])
+
+	var diags diag.Diagnostics
+	data.AuthProviders, diags = toLabels(ctx, rec.Values[3])
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Trace(ctx, "read the user")
+}
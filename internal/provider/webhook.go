@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ModelWebhook describes the settings of the webhook.
+type ModelWebhook struct {
+	URL     types.String `tfsdk:"url"`
+	Headers types.Map    `tfsdk:"headers"`
+	Timeout types.String `tfsdk:"timeout"`
+}
+
+// webhookDefaultTimeout is the default time to wait for the webhook's response.
+const webhookDefaultTimeout = 10 * time.Second
+
+// webhookBlock defines the schema of the webhooks settings.
+func webhookBlock() schema.Block {
+	return schema.ListNestedBlock{
+		MarkdownDescription: "The webhook notified after the node, or the relationship is created, updated, or deleted, " +
+			"e.g. to invalidate the application's graph cache right away. The webhook is sent the `POST` request " +
+			"with the JSON object with the attributes `resource_type`, `resource_id`, `operation`, `database` " +
+			"and `applied_at`." +
+			"\n\n-> **Note** The change is not rolled back if the webhook fails, the failure is reported as the warning.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"url": schema.StringAttribute{
+					MarkdownDescription: "The URL of the webhook, e.g. `https://cache.example.com/invalidate`.",
+					Required:            true,
+					Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				},
+				"headers": schema.MapAttribute{
+					MarkdownDescription: "The headers of the request, e.g. `{ Authorization = \"Bearer ...\" }`.",
+					Optional:            true,
+					Sensitive:           true,
+					ElementType:         types.StringType,
+				},
+				"timeout": schema.StringAttribute{
+					MarkdownDescription: "The maximum time to wait for the webhook's response, e.g. `30s`. " +
+						"Defaults to `10s`.",
+					Optional:   true,
+					Validators: durationValidators,
+				},
+			},
+		},
+	}
+}
+
+// webhook notifies the external service about the applied changes.
+type webhook struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+// webhookPayload describes the notification of the applied change.
+type webhookPayload struct {
+	ResourceType string    `json:"resource_type"`
+	ResourceID   any       `json:"resource_id"`
+	Operation    string    `json:"operation"`
+	Database     string    `json:"database"`
+	AppliedAt    time.Time `json:"applied_at"`
+}
+
+// newWebhooks defines the webhooks from the provider configuration.
+func newWebhooks(ctx context.Context, models []ModelWebhook) ([]*webhook, error) {
+	var o = make([]*webhook, 0, len(models))
+	for i, m := range models {
+		var w = &webhook{url: m.URL.ValueString(), client: &http.Client{Timeout: webhookDefaultTimeout}}
+		if !m.Timeout.IsNull() {
+			timeout, err := time.ParseDuration(m.Timeout.ValueString())
+			if err != nil || timeout <= 0 {
+				return nil, fmt.Errorf("invalid timeout of the webhook %d, expected the positive duration, "+
+					"e.g. 30s, got: %s", i, m.Timeout.ValueString())
+			}
+			w.client.Timeout = timeout
+		}
+		if !m.Headers.IsNull() {
+			if diags := m.Headers.ElementsAs(ctx, &w.headers, false); diags.HasError() {
+				return nil, fmt.Errorf("failed to read the headers of the webhook %d: %v", i, diags)
+			}
+		}
+		o = append(o, w)
+	}
+	return o, nil
+}
+
+// send posts the payload, the response with the status other than 2xx is treated as the failure.
+func (w *webhook) send(ctx context.Context, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range w.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response status: %s", resp.Status)
+	}
+	return nil
+}
+
+// notify sends the change to the webhooks unless it failed, it's no-op if no webhooks are configured.
+// It's deferred by the graph resources' Create, Update and Delete, hence the identifier is read once the change
+// is applied.
+func (c *Client) notify(ctx context.Context, diags *diag.Diagnostics, resourceType, operation string,
+	id *types.String) {
+	if c == nil || len(c.webhooks) == 0 || diags.HasError() {
+		return
+	}
+
+	var payload = webhookPayload{
+		ResourceType: resourceType,
+		Operation:    operation,
+		Database:     c.databaseLabel(),
+		AppliedAt:    time.Now().UTC(),
+	}
+	if id != nil && !id.IsNull() && !id.IsUnknown() {
+		payload.ResourceID = id.ValueString()
+	}
+	o, err := json.Marshal(payload)
+	if err != nil {
+		diags.AddWarning("failed to notify the webhooks", err.Error())
+		return
+	}
+
+	props := map[string]interface{}{"resource_type": resourceType, "operation": operation}
+	tflog.Trace(ctx, "notifying the webhooks", props)
+
+	var errs []error
+	for i, w := range c.webhooks {
+		if err := w.send(ctx, o); err != nil {
+			errs = append(errs, fmt.Errorf("webhook %d: %w", i, err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		tflog.Debug(ctx, "failed to notify the webhooks", props)
+		diags.AddWarning("failed to notify the webhooks",
+			fmt.Sprintf("The %s of %s was applied, but it's not notified: %v", operation, resourceType, err))
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWebhooks(t *testing.T) {
+	ctx := context.Background()
+	webhooks, err := newWebhooks(ctx, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, webhooks)
+
+	webhooks, err = newWebhooks(ctx, []ModelWebhook{
+		{URL: types.StringValue("http://localhost/a"), Headers: types.MapNull(types.StringType)},
+		{
+			URL: types.StringValue("http://localhost/b"),
+			Headers: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"Authorization": types.StringValue("Bearer foo"),
+			}),
+			Timeout: types.StringValue("1m"),
+		},
+	})
+	if assert.NoError(t, err) && assert.Len(t, webhooks, 2) {
+		assert.Equal(t, webhookDefaultTimeout, webhooks[0].client.Timeout)
+		assert.Equal(t, time.Minute, webhooks[1].client.Timeout)
+		assert.Equal(t, map[string]string{"Authorization": "Bearer foo"}, webhooks[1].headers)
+	}
+
+	_, err = newWebhooks(ctx, []ModelWebhook{{URL: types.StringValue("http://localhost"),
+		Headers: types.MapNull(types.StringType), Timeout: types.StringValue("0s")}})
+	assert.ErrorContains(t, err, "invalid timeout of the webhook 0")
+}
+
+func TestClientNotify(t *testing.T) {
+	ctx := context.Background()
+
+	var got []webhookPayload
+	var authorization string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload webhookPayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		got = append(got, payload)
+		authorization = r.Header.Get("Authorization")
+	}))
+	t.Cleanup(srv.Close)
+
+	c := &Client{database: "movies", webhooks: []*webhook{
+		{url: srv.URL, headers: map[string]string{"Authorization": "Bearer foo"}, client: srv.Client()},
+	}}
+
+	var diags diag.Diagnostics
+	id := types.StringValue("foo")
+	c.notify(ctx, &diags, "neo4j_node", auditOperationCreate, &id)
+	assert.False(t, diags.HasError())
+	assert.Empty(t, diags.Warnings())
+	if assert.Len(t, got, 1) {
+		assert.Equal(t, "neo4j_node", got[0].ResourceType)
+		assert.Equal(t, "foo", got[0].ResourceID)
+		assert.Equal(t, auditOperationCreate, got[0].Operation)
+		assert.Equal(t, "movies", got[0].Database)
+		assert.False(t, got[0].AppliedAt.IsZero())
+	}
+	assert.Equal(t, "Bearer foo", authorization)
+
+	// the failed change is not notified
+	diags.AddError("failed", "")
+	c.notify(ctx, &diags, "neo4j_node", auditOperationUpdate, &id)
+	assert.Len(t, got, 1)
+
+	// the failed webhook is reported as the warning
+	diags = nil
+	c.webhooks = append(c.webhooks, &webhook{url: srv.URL + "/\x00", client: srv.Client()})
+	c.notify(ctx, &diags, "neo4j_node", auditOperationDelete, &id)
+	assert.False(t, diags.HasError())
+	assert.Len(t, diags.Warnings(), 1)
+	assert.Len(t, got, 2)
+}
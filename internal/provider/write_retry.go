@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+const (
+	// writeRetryDefaultBaseDelay is the default delay before the first retry.
+	writeRetryDefaultBaseDelay = 100 * time.Millisecond
+	// writeRetryDefaultJitter is the default share of the delay it's randomized by.
+	writeRetryDefaultJitter = 0.2
+	// writeRetryMaxDelay caps the delay between the retries.
+	writeRetryMaxDelay = 30 * time.Second
+)
+
+// writeRetry retries the writes of the nodes and the relationships failed by the transient errors,
+// e.g. the deadlocks, or the leader switch in the cluster.
+type writeRetry struct {
+	// maxTime is the maximum time to retry the write for.
+	maxTime time.Duration
+	// baseDelay is the delay before the first retry, it's doubled for every next retry.
+	baseDelay time.Duration
+	// jitter is the share of the delay it's randomized by, e.g. 0.2 for ±20%.
+	jitter float64
+}
+
+// newWriteRetry defines the retry, the durations are parsed from the provider configuration.
+func newWriteRetry(maxTime, baseDelay string, jitter float64) (*writeRetry, error) {
+	var r = &writeRetry{baseDelay: writeRetryDefaultBaseDelay, jitter: jitter}
+	var err error
+	if r.maxTime, err = time.ParseDuration(maxTime); err != nil || r.maxTime < 0 {
+		return nil, fmt.Errorf("invalid write retry max time, expected the duration, e.g. 30s, got: %s", maxTime)
+	}
+	if baseDelay != "" {
+		if r.baseDelay, err = time.ParseDuration(baseDelay); err != nil || r.baseDelay <= 0 {
+			return nil, fmt.Errorf("invalid write retry base delay, expected the positive duration, e.g. 100ms, "+
+				"got: %s", baseDelay)
+		}
+	}
+	if jitter < 0 || jitter > 1 {
+		return nil, fmt.Errorf("invalid write retry jitter, expected the value from 0 to 1, got: %v", jitter)
+	}
+	return r, nil
+}
+
+// delay defines the backoff before the retry following the attempt, counted from 0.
+// The random value from 0 to 1 randomizes the delay by the jitter to spread the concurrent retries.
+func (r *writeRetry) delay(attempt int, random float64) time.Duration {
+	d := r.baseDelay
+	for i := 0; i < attempt && d < writeRetryMaxDelay; i++ {
+		d *= 2
+	}
+	d = min(d, writeRetryMaxDelay)
+	return d + time.Duration((2*random-1)*r.jitter*float64(d))
+}
+
+// do runs the write until it succeeds, fails by the error which is not retryable, or the max retry time elapses.
+// The write is run once if the retry is not configured.
+func (r *writeRetry) do(ctx context.Context, write func() error) error {
+	if r == nil {
+		return write()
+	}
+	deadline := time.Now().Add(r.maxTime)
+	for attempt := 0; ; attempt++ {
+		err := write()
+		if err == nil || !neo4j.IsRetryable(err) {
+			return err
+		}
+		d := r.delay(attempt, rand.Float64())
+		if time.Now().Add(d).After(deadline) {
+			return err
+		}
+
+		tflog.Debug(ctx, "retrying the write", map[string]interface{}{
+			"attempt": attempt + 1, "delay": d.String(), "error": err.Error(),
+		})
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(d):
+		}
+	}
+}
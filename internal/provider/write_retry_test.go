@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// Copyright (c) Dmitry Kisler
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWriteRetry(t *testing.T) {
+	r, err := newWriteRetry("30s", "", writeRetryDefaultJitter)
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Second, r.maxTime)
+	assert.Equal(t, writeRetryDefaultBaseDelay, r.baseDelay)
+	assert.Equal(t, writeRetryDefaultJitter, r.jitter)
+
+	r, err = newWriteRetry("5m", "1s", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, r.maxTime)
+	assert.Equal(t, time.Second, r.baseDelay)
+	assert.Zero(t, r.jitter)
+
+	_, err = newWriteRetry("5 minutes", "", 0)
+	assert.ErrorContains(t, err, "invalid write retry max time")
+
+	_, err = newWriteRetry("5m", "0s", 0)
+	assert.ErrorContains(t, err, "invalid write retry base delay")
+
+	_, err = newWriteRetry("5m", "", 1.5)
+	assert.ErrorContains(t, err, "invalid write retry jitter")
+}
+
+func TestWriteRetryDelay(t *testing.T) {
+	r := &writeRetry{baseDelay: 100 * time.Millisecond, jitter: 0.2}
+	assert.Equal(t, 100*time.Millisecond, r.delay(0, 0.5))
+	assert.Equal(t, 400*time.Millisecond, r.delay(2, 0.5))
+	assert.Equal(t, 320*time.Millisecond, r.delay(2, 0))
+	assert.Equal(t, 480*time.Millisecond, r.delay(2, 1))
+	assert.Equal(t, writeRetryMaxDelay, r.delay(100, 0.5), "the delay shall be capped")
+}
+
+func TestWriteRetryDo(t *testing.T) {
+	ctx := context.Background()
+	transient := &neo4j.Neo4jError{Code: "Neo.TransientError.Transaction.DeadlockDetected", Msg: "deadlock"}
+
+	var calls int
+	err := (*writeRetry)(nil).do(ctx, func() error { calls++; return transient })
+	assert.ErrorIs(t, err, transient)
+	assert.Equal(t, 1, calls, "the write shall be run once if the retry is not configured")
+
+	r := &writeRetry{maxTime: time.Second, baseDelay: time.Millisecond}
+
+	calls = 0
+	err = r.do(ctx, func() error {
+		calls++
+		if calls < 3 {
+			return transient
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+
+	calls = 0
+	permanent := errors.New("syntax error")
+	err = r.do(ctx, func() error { calls++; return permanent })
+	assert.ErrorIs(t, err, permanent)
+	assert.Equal(t, 1, calls, "the error which is not retryable shall not be retried")
+
+	calls = 0
+	r.maxTime = 10 * time.Millisecond
+	err = r.do(ctx, func() error { calls++; return transient })
+	assert.ErrorIs(t, err, transient)
+	assert.Greater(t, calls, 1)
+}